@@ -1,5 +1,25 @@
 package config
 
+import (
+	"errors"
+	"time"
+)
+
 type Application struct {
-	AccountPort string `env:"ACCOUNT_PORT"`
+	AccountPort     string        `env:"ACCOUNT_PORT"`
+	GRPCReflection  bool          `env:"GRPC_REFLECTION"`
+	MigrateOnStart  bool          `env:"MIGRATE_ON_START" envDefault:"true"`
+	MetricsPort     string        `env:"METRICS_PORT" envDefault:":9101"`
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"30s"`
+}
+
+func (a *Application) Validate() error {
+	var errs []error
+	if a.AccountPort == "" {
+		errs = append(errs, errors.New("ACCOUNT_PORT is required"))
+	}
+	if a.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT must be positive"))
+	}
+	return errors.Join(errs...)
 }
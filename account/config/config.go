@@ -1,7 +1,8 @@
 package config
 
 import (
-	"github.com/caarlos0/env/v11"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/configx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
 	"sync"
 )
 
@@ -13,13 +14,15 @@ var (
 
 type Config struct {
 	Application Application
-	Postgresql  Postgresql
+	Postgresql  postgres.Config `envPrefix:"ACCOUNT_POSTGRES_"`
+	Tracing     Tracing
+	TLS         TLS
 }
 
 func NewConfig() (*Config, error) {
 	once.Do(func() {
 		instance = &Config{}
-		initErr = env.Parse(instance)
+		initErr = configx.Load(instance)
 		if initErr != nil {
 			instance = nil
 		}
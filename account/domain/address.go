@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+type Address struct {
+	Id         string    `json:"id"`
+	AccountId  string    `json:"account_id"`
+	Recipient  string    `json:"recipient"`
+	Line1      string    `json:"line1"`
+	Line2      string    `json:"line2"`
+	City       string    `json:"city"`
+	Region     string    `json:"region"`
+	PostalCode string    `json:"postal_code"`
+	Country    string    `json:"country"`
+	Phone      string    `json:"phone"`
+	IsDefault  bool      `json:"is_default"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
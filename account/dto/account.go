@@ -5,6 +5,21 @@ type Account struct {
 }
 
 type AccountQuery struct {
-	Limit  uint64 `json:"limit"`
-	Offset uint64 `json:"offset"`
+	Limit  uint64   `json:"limit"`
+	Offset uint64   `json:"offset"`
+	Ids    []string `json:"ids,omitempty"`
+}
+
+type Address struct {
+	Id         string `json:"id"`
+	AccountId  string `json:"account_id"`
+	Recipient  string `json:"recipient"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
+	IsDefault  bool   `json:"is_default"`
 }
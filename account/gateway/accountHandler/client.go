@@ -5,14 +5,24 @@ import (
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"time"
 )
 
 type GRPCAccountClient interface {
 	CreateAccount(ctx context.Context, input *dto.Account) (*domain.Account, error)
 	GetAccountById(ctx context.Context, id string) (*domain.Account, error)
 	GetAccounts(ctx context.Context, input *dto.AccountQuery) ([]*domain.Account, error)
+	CreateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error)
+	UpdateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error)
+	GetAddress(ctx context.Context, id string) (*domain.Address, error)
+	GetAddressesForAccount(ctx context.Context, accountId string) ([]*domain.Address, error)
+	DeleteAddress(ctx context.Context, id, accountId string) error
 	Close() error
 }
 
@@ -47,7 +57,7 @@ func (g *gRPCAccountClient) GetAccountById(ctx context.Context, id string) (*dom
 }
 
 func (g *gRPCAccountClient) GetAccounts(ctx context.Context, input *dto.AccountQuery) ([]*domain.Account, error) {
-	req := &proto.GetAccountsRequest{Limit: input.Limit, Offset: input.Offset}
+	req := &proto.GetAccountsRequest{Limit: input.Limit, Offset: input.Offset, Ids: input.Ids}
 	resp, err := g.client.GetAccounts(ctx, req)
 	if err != nil {
 		return nil, err
@@ -63,12 +73,114 @@ func (g *gRPCAccountClient) GetAccounts(ctx context.Context, input *dto.AccountQ
 	return accounts, nil
 }
 
+func (g *gRPCAccountClient) CreateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error) {
+	resp, err := g.client.CreateAddress(ctx, toAddressRequest(input))
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAddress(resp.Address)
+}
+
+func (g *gRPCAccountClient) UpdateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error) {
+	resp, err := g.client.UpdateAddress(ctx, toAddressRequest(input))
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAddress(resp.Address)
+}
+
+func (g *gRPCAccountClient) GetAddress(ctx context.Context, id string) (*domain.Address, error) {
+	resp, err := g.client.GetAddress(ctx, &proto.GetAddressRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAddress(resp.Address)
+}
+
+func (g *gRPCAccountClient) GetAddressesForAccount(ctx context.Context, accountId string) ([]*domain.Address, error) {
+	resp, err := g.client.GetAddressesForAccount(ctx, &proto.GetAddressesForAccountRequest{AccountId: accountId})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]*domain.Address, len(resp.Addresses))
+	for i, a := range resp.Addresses {
+		address, err := toDomainAddress(a)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = address
+	}
+	return addresses, nil
+}
+
+func (g *gRPCAccountClient) DeleteAddress(ctx context.Context, id, accountId string) error {
+	_, err := g.client.DeleteAddress(ctx, &proto.DeleteAddressRequest{Id: id, AccountId: accountId})
+	return err
+}
+
 func (g *gRPCAccountClient) Close() error {
 	return g.conn.Close()
 }
 
-func NewGRPCAccountClient(addr string) (GRPCAccountClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func toAddressRequest(input *dto.Address) *proto.AddressRequest {
+	return &proto.AddressRequest{
+		Id:         input.Id,
+		AccountId:  input.AccountId,
+		Recipient:  input.Recipient,
+		Line1:      input.Line1,
+		Line2:      input.Line2,
+		City:       input.City,
+		Region:     input.Region,
+		PostalCode: input.PostalCode,
+		Country:    input.Country,
+		Phone:      input.Phone,
+		IsDefault:  input.IsDefault,
+	}
+}
+
+func toDomainAddress(a *proto.Address) (*domain.Address, error) {
+	var createdAt, updatedAt time.Time
+	if len(a.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(a.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+	if len(a.UpdatedAt) > 0 {
+		if err := updatedAt.UnmarshalBinary(a.UpdatedAt); err != nil {
+			return nil, err
+		}
+	}
+	return &domain.Address{
+		Id:         a.Id,
+		AccountId:  a.AccountId,
+		Recipient:  a.Recipient,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Phone:      a.Phone,
+		IsDefault:  a.IsDefault,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}, nil
+}
+
+var accountReads = []string{"GetAccountById", "GetAccounts", "GetAddress", "GetAddressesForAccount"}
+
+func NewGRPCAccountClient(addr string, creds *mtls.Credentials, retry grpcx.RetryPolicy, breaker *grpcx.Breaker, dialOpts ...grpc.DialOption) (GRPCAccountClient, error) {
+	opts := []grpc.DialOption{
+		creds.DialOption(),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), postgres.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor()),
+	}
+	opts = append(opts, grpcx.DialOptions()...)
+	opts = append(opts, grpcx.ResilienceOptions(proto.AccountService_ServiceDesc.ServiceName, accountReads, retry, breaker)...)
+	opts = append(opts, dialOpts...)
+	conn, err := grpc.NewClient(addr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,15 @@
+package accountHandler
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"google.golang.org/grpc/codes"
+)
+
+var errorMapper = grpcx.NewErrorMapper("account",
+	grpcx.ErrorRule{Err: repository.ErrNoRows, Code: codes.NotFound, Reason: "NOT_FOUND"},
+	grpcx.ErrorRule{Err: service.ErrInvalidAddress, Code: codes.InvalidArgument, Reason: "INVALID_ADDRESS", Field: "address"},
+	grpcx.ErrorRule{Err: service.ErrInvalidCountry, Code: codes.InvalidArgument, Reason: "INVALID_COUNTRY", Field: "address.country"},
+	grpcx.ErrorRule{Err: service.ErrTooManyIds, Code: codes.InvalidArgument, Reason: "TOO_MANY_IDS", Field: "ids"},
+)
@@ -2,10 +2,18 @@ package accountHandler
 
 import (
 	"context"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/proto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"net"
 )
 
@@ -13,12 +21,22 @@ type GRPCAccountServer interface {
 	CreateAccount(ctx context.Context, req *proto.CreateAccountRequest) (*proto.CreateAccountResponse, error)
 	GetAccountById(ctx context.Context, req *proto.GetAccountRequest) (*proto.GetAccountResponse, error)
 	GetAccounts(ctx context.Context, req *proto.GetAccountsRequest) (*proto.GetAccountsResponse, error)
+	CreateAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressResponse, error)
+	UpdateAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressResponse, error)
+	GetAddress(ctx context.Context, req *proto.GetAddressRequest) (*proto.AddressResponse, error)
+	GetAddressesForAccount(ctx context.Context, req *proto.GetAddressesForAccountRequest) (*proto.GetAddressesForAccountResponse, error)
+	DeleteAddress(ctx context.Context, req *proto.DeleteAddressRequest) (*proto.DeleteAddressResponse, error)
 	Serve(addr string) error
+	ServeListener(lis net.Listener) error
 	Stop() error
 }
 
 type gRPCAccountServer struct {
 	accountService service.AccountService
+	addressService service.AddressService
+	checker        *health.Checker
+	reflection     bool
+	creds          *mtls.Credentials
 	server         *grpc.Server
 	proto.UnimplementedAccountServiceServer
 }
@@ -54,10 +72,16 @@ func (g *gRPCAccountServer) GetAccountById(ctx context.Context, req *proto.GetAc
 }
 
 func (g *gRPCAccountServer) GetAccounts(ctx context.Context, req *proto.GetAccountsRequest) (*proto.GetAccountsResponse, error) {
-	accounts, err := g.accountService.GetAccounts(ctx, &dto.AccountQuery{
-		Limit:  req.Limit,
-		Offset: req.Offset,
-	})
+	var accounts []*domain.Account
+	var err error
+	if len(req.Ids) != 0 {
+		accounts, err = g.accountService.GetAccountsByIds(ctx, req.Ids)
+	} else {
+		accounts, err = g.accountService.GetAccounts(ctx, &dto.AccountQuery{
+			Limit:  req.Limit,
+			Offset: req.Offset,
+		})
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -75,25 +99,135 @@ func (g *gRPCAccountServer) GetAccounts(ctx context.Context, req *proto.GetAccou
 	}, nil
 }
 
+func (g *gRPCAccountServer) CreateAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressResponse, error) {
+	address, err := g.addressService.CreateAddress(ctx, toAddressDTO(req))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.AddressResponse{
+		Address: toProtoAddress(address),
+	}, nil
+}
+
+func (g *gRPCAccountServer) UpdateAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressResponse, error) {
+	address, err := g.addressService.UpdateAddress(ctx, toAddressDTO(req))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.AddressResponse{
+		Address: toProtoAddress(address),
+	}, nil
+}
+
+func (g *gRPCAccountServer) GetAddress(ctx context.Context, req *proto.GetAddressRequest) (*proto.AddressResponse, error) {
+	address, err := g.addressService.GetAddressById(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.AddressResponse{
+		Address: toProtoAddress(address),
+	}, nil
+}
+
+func (g *gRPCAccountServer) GetAddressesForAccount(ctx context.Context, req *proto.GetAddressesForAccountRequest) (*proto.GetAddressesForAccountResponse, error) {
+	addresses, err := g.addressService.GetAddressesForAccount(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoAddresses := make([]*proto.Address, len(addresses))
+	for i, address := range addresses {
+		protoAddresses[i] = toProtoAddress(address)
+	}
+
+	return &proto.GetAddressesForAccountResponse{
+		Addresses: protoAddresses,
+	}, nil
+}
+
+func (g *gRPCAccountServer) DeleteAddress(ctx context.Context, req *proto.DeleteAddressRequest) (*proto.DeleteAddressResponse, error) {
+	if err := g.addressService.DeleteAddress(ctx, req.Id, req.AccountId); err != nil {
+		return nil, err
+	}
+	return &proto.DeleteAddressResponse{}, nil
+}
+
 func (g *gRPCAccountServer) Serve(addr string) error {
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		return err
 	}
-	g.server = grpc.NewServer()
+	return g.ServeListener(lis)
+}
+
+// ServeListener serves on lis until the server stops, closing it then.
+func (g *gRPCAccountServer) ServeListener(lis net.Listener) error {
+	opts := g.creds.ServerOptions()
+	opts = append(opts,
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), postgres.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), postgres.StreamServerInterceptor()),
+	)
+	opts = append(opts, grpcx.ServerOptions(errorMapper)...)
+	g.server = grpc.NewServer(opts...)
 	proto.RegisterAccountServiceServer(g.server, g)
+	g.checker.Register(g.server)
+	metrics.InitializeServer(g.server)
+	if g.reflection {
+		reflection.Register(g.server)
+	}
 	return g.server.Serve(lis)
 }
 
 func (g *gRPCAccountServer) Stop() error {
+	g.checker.Shutdown()
 	if g.server != nil {
 		g.server.GracefulStop()
 	}
 	return nil
 }
 
-func NewGRPCServer(accountService service.AccountService) GRPCAccountServer {
+func toAddressDTO(req *proto.AddressRequest) *dto.Address {
+	return &dto.Address{
+		Id:         req.Id,
+		AccountId:  req.AccountId,
+		Recipient:  req.Recipient,
+		Line1:      req.Line1,
+		Line2:      req.Line2,
+		City:       req.City,
+		Region:     req.Region,
+		PostalCode: req.PostalCode,
+		Country:    req.Country,
+		Phone:      req.Phone,
+		IsDefault:  req.IsDefault,
+	}
+}
+
+func toProtoAddress(address *domain.Address) *proto.Address {
+	addressProto := &proto.Address{
+		Id:         address.Id,
+		AccountId:  address.AccountId,
+		Recipient:  address.Recipient,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+		Phone:      address.Phone,
+		IsDefault:  address.IsDefault,
+	}
+	addressProto.CreatedAt, _ = address.CreatedAt.MarshalBinary()
+	addressProto.UpdatedAt, _ = address.UpdatedAt.MarshalBinary()
+	return addressProto
+}
+
+func NewGRPCServer(accountService service.AccountService, addressService service.AddressService, checker *health.Checker, reflection bool, creds *mtls.Credentials) GRPCAccountServer {
 	return &gRPCAccountServer{
 		accountService: accountService,
+		addressService: addressService,
+		checker:        checker,
+		reflection:     reflection,
+		creds:          creds,
 	}
 }
@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -73,6 +74,146 @@ func (x *Account) GetName() string {
 	return ""
 }
 
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Recipient     string                 `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1         string                 `protobuf:"bytes,4,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,5,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Country       string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	Phone         string                 `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,11,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	CreatedAt     []byte                 `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     []byte                 `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_gateway_proto_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Address) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Address) GetCreatedAt() []byte {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Address) GetUpdatedAt() []byte {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 type CreateAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -82,7 +223,7 @@ type CreateAccountRequest struct {
 
 func (x *CreateAccountRequest) Reset() {
 	*x = CreateAccountRequest{}
-	mi := &file_gateway_proto_account_proto_msgTypes[1]
+	mi := &file_gateway_proto_account_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +235,7 @@ func (x *CreateAccountRequest) String() string {
 func (*CreateAccountRequest) ProtoMessage() {}
 
 func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_account_proto_msgTypes[1]
+	mi := &file_gateway_proto_account_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +248,7 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
 func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_account_proto_rawDescGZIP(), []int{1}
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateAccountRequest) GetName() string {
@@ -126,7 +267,7 @@ type CreateAccountResponse struct {
 
 func (x *CreateAccountResponse) Reset() {
 	*x = CreateAccountResponse{}
-	mi := &file_gateway_proto_account_proto_msgTypes[2]
+	mi := &file_gateway_proto_account_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +279,7 @@ func (x *CreateAccountResponse) String() string {
 func (*CreateAccountResponse) ProtoMessage() {}
 
 func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_account_proto_msgTypes[2]
+	mi := &file_gateway_proto_account_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +292,7 @@ func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
 func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_account_proto_rawDescGZIP(), []int{2}
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateAccountResponse) GetAccount() *Account {
@@ -170,7 +311,7 @@ type GetAccountRequest struct {
 
 func (x *GetAccountRequest) Reset() {
 	*x = GetAccountRequest{}
-	mi := &file_gateway_proto_account_proto_msgTypes[3]
+	mi := &file_gateway_proto_account_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -182,7 +323,7 @@ func (x *GetAccountRequest) String() string {
 func (*GetAccountRequest) ProtoMessage() {}
 
 func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_account_proto_msgTypes[3]
+	mi := &file_gateway_proto_account_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +336,7 @@ func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_account_proto_rawDescGZIP(), []int{3}
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetAccountRequest) GetId() string {
@@ -214,7 +355,7 @@ type GetAccountResponse struct {
 
 func (x *GetAccountResponse) Reset() {
 	*x = GetAccountResponse{}
-	mi := &file_gateway_proto_account_proto_msgTypes[4]
+	mi := &file_gateway_proto_account_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +367,7 @@ func (x *GetAccountResponse) String() string {
 func (*GetAccountResponse) ProtoMessage() {}
 
 func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_account_proto_msgTypes[4]
+	mi := &file_gateway_proto_account_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +380,7 @@ func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
 func (*GetAccountResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_account_proto_rawDescGZIP(), []int{4}
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetAccountResponse) GetAccount() *Account {
@@ -253,13 +394,14 @@ type GetAccountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         uint64                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        uint64                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Ids           []string               `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAccountsRequest) Reset() {
 	*x = GetAccountsRequest{}
-	mi := &file_gateway_proto_account_proto_msgTypes[5]
+	mi := &file_gateway_proto_account_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +413,7 @@ func (x *GetAccountsRequest) String() string {
 func (*GetAccountsRequest) ProtoMessage() {}
 
 func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_account_proto_msgTypes[5]
+	mi := &file_gateway_proto_account_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +426,7 @@ func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_account_proto_rawDescGZIP(), []int{5}
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetAccountsRequest) GetLimit() uint64 {
@@ -301,6 +443,13 @@ func (x *GetAccountsRequest) GetOffset() uint64 {
 	return 0
 }
 
+func (x *GetAccountsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 type GetAccountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
@@ -310,7 +459,7 @@ type GetAccountsResponse struct {
 
 func (x *GetAccountsResponse) Reset() {
 	*x = GetAccountsResponse{}
-	mi := &file_gateway_proto_account_proto_msgTypes[6]
+	mi := &file_gateway_proto_account_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -322,7 +471,7 @@ func (x *GetAccountsResponse) String() string {
 func (*GetAccountsResponse) ProtoMessage() {}
 
 func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_account_proto_msgTypes[6]
+	mi := &file_gateway_proto_account_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +484,7 @@ func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
 func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_account_proto_rawDescGZIP(), []int{6}
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetAccountsResponse) GetAccounts() []*Account {
@@ -345,14 +494,419 @@ func (x *GetAccountsResponse) GetAccounts() []*Account {
 	return nil
 }
 
+type AddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Recipient     string                 `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1         string                 `protobuf:"bytes,4,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,5,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Country       string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	Phone         string                 `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,11,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressRequest) Reset() {
+	*x = AddressRequest{}
+	mi := &file_gateway_proto_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRequest) ProtoMessage() {}
+
+func (x *AddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressRequest.ProtoReflect.Descriptor instead.
+func (*AddressRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type AddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressResponse) Reset() {
+	*x = AddressResponse{}
+	mi := &file_gateway_proto_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressResponse) ProtoMessage() {}
+
+func (x *AddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressResponse.ProtoReflect.Descriptor instead.
+func (*AddressResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type GetAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressRequest) Reset() {
+	*x = GetAddressRequest{}
+	mi := &file_gateway_proto_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressRequest) ProtoMessage() {}
+
+func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAddressesForAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressesForAccountRequest) Reset() {
+	*x = GetAddressesForAccountRequest{}
+	mi := &file_gateway_proto_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressesForAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressesForAccountRequest) ProtoMessage() {}
+
+func (x *GetAddressesForAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressesForAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressesForAccountRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAddressesForAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetAddressesForAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*Address             `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressesForAccountResponse) Reset() {
+	*x = GetAddressesForAccountResponse{}
+	mi := &file_gateway_proto_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressesForAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressesForAccountResponse) ProtoMessage() {}
+
+func (x *GetAddressesForAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressesForAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressesForAccountResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAddressesForAccountResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type DeleteAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressRequest) Reset() {
+	*x = DeleteAddressRequest{}
+	mi := &file_gateway_proto_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressRequest) ProtoMessage() {}
+
+func (x *DeleteAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteAddressRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type DeleteAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressResponse) Reset() {
+	*x = DeleteAddressResponse{}
+	mi := &file_gateway_proto_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressResponse) ProtoMessage() {}
+
+func (x *DeleteAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddressResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_account_proto_rawDescGZIP(), []int{14}
+}
+
 var File_gateway_proto_account_proto protoreflect.FileDescriptor
 
 const file_gateway_proto_account_proto_rawDesc = "" +
 	"\n" +
-	"\x1bgateway/proto/account.proto\x12\aaccount\"-\n" +
+	"\x1bgateway/proto/account.proto\x12\aaccount\x1a\x1cgoogle/api/annotations.proto\"-\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"*\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd7\x02\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\x12\x1c\n" +
+	"\trecipient\x18\x03 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x04 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x05 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\a \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"postalCode\x18\b \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x1c\n" +
+	"\tisDefault\x18\v \x01(\bR\tisDefault\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\fR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\r \x01(\fR\tupdatedAt\"*\n" +
 	"\x14CreateAccountRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
 	"\x15CreateAccountResponse\x12*\n" +
@@ -360,16 +914,50 @@ const file_gateway_proto_account_proto_rawDesc = "" +
 	"\x11GetAccountRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
 	"\x12GetAccountResponse\x12*\n" +
-	"\aaccount\x18\x01 \x01(\v2\x10.account.AccountR\aaccount\"B\n" +
+	"\aaccount\x18\x01 \x01(\v2\x10.account.AccountR\aaccount\"T\n" +
 	"\x12GetAccountsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x04R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x04R\x06offset\"C\n" +
+	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12\x10\n" +
+	"\x03ids\x18\x03 \x03(\tR\x03ids\"C\n" +
 	"\x13GetAccountsResponse\x12,\n" +
-	"\baccounts\x18\x01 \x03(\v2\x10.account.AccountR\baccounts2\xfb\x01\n" +
-	"\x0eAccountService\x12P\n" +
-	"\rCreateAccount\x12\x1d.account.CreateAccountRequest\x1a\x1e.account.CreateAccountResponse\"\x00\x12K\n" +
-	"\x0eGetAccountById\x12\x1a.account.GetAccountRequest\x1a\x1b.account.GetAccountResponse\"\x00\x12J\n" +
-	"\vGetAccounts\x12\x1b.account.GetAccountsRequest\x1a\x1c.account.GetAccountsResponse\"\x00B\x0fZ\rgateway/protob\x06proto3"
+	"\baccounts\x18\x01 \x03(\v2\x10.account.AccountR\baccounts\"\xa2\x02\n" +
+	"\x0eAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\x12\x1c\n" +
+	"\trecipient\x18\x03 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x04 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x05 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\a \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"postalCode\x18\b \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x1c\n" +
+	"\tisDefault\x18\v \x01(\bR\tisDefault\"=\n" +
+	"\x0fAddressResponse\x12*\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.account.AddressR\aaddress\"#\n" +
+	"\x11GetAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x1dGetAddressesForAccountRequest\x12\x1c\n" +
+	"\taccountId\x18\x01 \x01(\tR\taccountId\"P\n" +
+	"\x1eGetAddressesForAccountResponse\x12.\n" +
+	"\taddresses\x18\x01 \x03(\v2\x10.account.AddressR\taddresses\"D\n" +
+	"\x14DeleteAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\"\x17\n" +
+	"\x15DeleteAddressResponse2\xa3\a\n" +
+	"\x0eAccountService\x12g\n" +
+	"\rCreateAccount\x12\x1d.account.CreateAccountRequest\x1a\x1e.account.CreateAccountResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12d\n" +
+	"\x0eGetAccountById\x12\x1a.account.GetAccountRequest\x1a\x1b.account.GetAccountResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/accounts/{id}\x12^\n" +
+	"\vGetAccounts\x12\x1b.account.GetAccountsRequest\x1a\x1c.account.GetAccountsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accounts\x12q\n" +
+	"\rCreateAddress\x12\x17.account.AddressRequest\x1a\x18.account.AddressResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/accounts/{accountId}/addresses\x12v\n" +
+	"\rUpdateAddress\x12\x17.account.AddressRequest\x1a\x18.account.AddressResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/v1/accounts/{accountId}/addresses/{id}\x12^\n" +
+	"\n" +
+	"GetAddress\x12\x1a.account.GetAddressRequest\x1a\x18.account.AddressResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/addresses/{id}\x12\x95\x01\n" +
+	"\x16GetAddressesForAccount\x12&.account.GetAddressesForAccountRequest\x1a'.account.GetAddressesForAccountResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/accounts/{accountId}/addresses\x12\x7f\n" +
+	"\rDeleteAddress\x12\x1d.account.DeleteAddressRequest\x1a\x1e.account.DeleteAddressResponse\"/\x82\xd3\xe4\x93\x02)*'/v1/accounts/{accountId}/addresses/{id}B\x0fZ\rgateway/protob\x06proto3"
 
 var (
 	file_gateway_proto_account_proto_rawDescOnce sync.Once
@@ -383,31 +971,51 @@ func file_gateway_proto_account_proto_rawDescGZIP() []byte {
 	return file_gateway_proto_account_proto_rawDescData
 }
 
-var file_gateway_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_gateway_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_gateway_proto_account_proto_goTypes = []any{
-	(*Account)(nil),               // 0: account.Account
-	(*CreateAccountRequest)(nil),  // 1: account.CreateAccountRequest
-	(*CreateAccountResponse)(nil), // 2: account.CreateAccountResponse
-	(*GetAccountRequest)(nil),     // 3: account.GetAccountRequest
-	(*GetAccountResponse)(nil),    // 4: account.GetAccountResponse
-	(*GetAccountsRequest)(nil),    // 5: account.GetAccountsRequest
-	(*GetAccountsResponse)(nil),   // 6: account.GetAccountsResponse
+	(*Account)(nil),                        // 0: account.Account
+	(*Address)(nil),                        // 1: account.Address
+	(*CreateAccountRequest)(nil),           // 2: account.CreateAccountRequest
+	(*CreateAccountResponse)(nil),          // 3: account.CreateAccountResponse
+	(*GetAccountRequest)(nil),              // 4: account.GetAccountRequest
+	(*GetAccountResponse)(nil),             // 5: account.GetAccountResponse
+	(*GetAccountsRequest)(nil),             // 6: account.GetAccountsRequest
+	(*GetAccountsResponse)(nil),            // 7: account.GetAccountsResponse
+	(*AddressRequest)(nil),                 // 8: account.AddressRequest
+	(*AddressResponse)(nil),                // 9: account.AddressResponse
+	(*GetAddressRequest)(nil),              // 10: account.GetAddressRequest
+	(*GetAddressesForAccountRequest)(nil),  // 11: account.GetAddressesForAccountRequest
+	(*GetAddressesForAccountResponse)(nil), // 12: account.GetAddressesForAccountResponse
+	(*DeleteAddressRequest)(nil),           // 13: account.DeleteAddressRequest
+	(*DeleteAddressResponse)(nil),          // 14: account.DeleteAddressResponse
 }
 var file_gateway_proto_account_proto_depIdxs = []int32{
-	0, // 0: account.CreateAccountResponse.account:type_name -> account.Account
-	0, // 1: account.GetAccountResponse.account:type_name -> account.Account
-	0, // 2: account.GetAccountsResponse.accounts:type_name -> account.Account
-	1, // 3: account.AccountService.CreateAccount:input_type -> account.CreateAccountRequest
-	3, // 4: account.AccountService.GetAccountById:input_type -> account.GetAccountRequest
-	5, // 5: account.AccountService.GetAccounts:input_type -> account.GetAccountsRequest
-	2, // 6: account.AccountService.CreateAccount:output_type -> account.CreateAccountResponse
-	4, // 7: account.AccountService.GetAccountById:output_type -> account.GetAccountResponse
-	6, // 8: account.AccountService.GetAccounts:output_type -> account.GetAccountsResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: account.CreateAccountResponse.account:type_name -> account.Account
+	0,  // 1: account.GetAccountResponse.account:type_name -> account.Account
+	0,  // 2: account.GetAccountsResponse.accounts:type_name -> account.Account
+	1,  // 3: account.AddressResponse.address:type_name -> account.Address
+	1,  // 4: account.GetAddressesForAccountResponse.addresses:type_name -> account.Address
+	2,  // 5: account.AccountService.CreateAccount:input_type -> account.CreateAccountRequest
+	4,  // 6: account.AccountService.GetAccountById:input_type -> account.GetAccountRequest
+	6,  // 7: account.AccountService.GetAccounts:input_type -> account.GetAccountsRequest
+	8,  // 8: account.AccountService.CreateAddress:input_type -> account.AddressRequest
+	8,  // 9: account.AccountService.UpdateAddress:input_type -> account.AddressRequest
+	10, // 10: account.AccountService.GetAddress:input_type -> account.GetAddressRequest
+	11, // 11: account.AccountService.GetAddressesForAccount:input_type -> account.GetAddressesForAccountRequest
+	13, // 12: account.AccountService.DeleteAddress:input_type -> account.DeleteAddressRequest
+	3,  // 13: account.AccountService.CreateAccount:output_type -> account.CreateAccountResponse
+	5,  // 14: account.AccountService.GetAccountById:output_type -> account.GetAccountResponse
+	7,  // 15: account.AccountService.GetAccounts:output_type -> account.GetAccountsResponse
+	9,  // 16: account.AccountService.CreateAddress:output_type -> account.AddressResponse
+	9,  // 17: account.AccountService.UpdateAddress:output_type -> account.AddressResponse
+	9,  // 18: account.AccountService.GetAddress:output_type -> account.AddressResponse
+	12, // 19: account.AccountService.GetAddressesForAccount:output_type -> account.GetAddressesForAccountResponse
+	14, // 20: account.AccountService.DeleteAddress:output_type -> account.DeleteAddressResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_gateway_proto_account_proto_init() }
@@ -421,7 +1029,7 @@ func file_gateway_proto_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_proto_account_proto_rawDesc), len(file_gateway_proto_account_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
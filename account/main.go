@@ -2,20 +2,29 @@ package main
 
 import (
 	"context"
+	"errors"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/config"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/proto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/migrations"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/service"
-	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/utils"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/app"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"log/slog"
+	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrate(os.Args[2:]))
+	}
+
 	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 
 	cfg, err := config.NewConfig()
@@ -24,82 +33,100 @@ func main() {
 		os.Exit(1)
 	}
 
-	postgres := utils.NewPostgresql(
-		utils.WithHost(cfg.Postgresql.Host),
-		utils.WithPort(cfg.Postgresql.Port),
-		utils.WithUser(cfg.Postgresql.User),
-		utils.WithPassword(cfg.Postgresql.Password),
-		utils.WithName(cfg.Postgresql.Name),
-		utils.WithMaxOpenConns(cfg.Postgresql.MaxOpenConns),
-		utils.WithMaxIdleConns(cfg.Postgresql.MaxIdleConns),
-		utils.WithMaxIdleTime(cfg.Postgresql.MaxIdleTime),
-		utils.WithSSLMode(cfg.Postgresql.SSLMode),
-		utils.WithTimeout(cfg.Postgresql.Timeout),
-	)
+	a := app.New("account", cfg.Application.ShutdownTimeout)
 
-	db, err := postgres.Connect()
+	shutdownTracing, err := tracing.Setup(context.Background(), "account", cfg.Tracing.Exporter)
 	if err != nil {
-		slog.Error("postgres.connect.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("tracing.setup.failed", err)
 	}
-
-	defer func() {
-		if closeErr := db.Close(); closeErr != nil {
-			slog.Error("postgres.close.failed", slog.String("error", closeErr.Error()))
-		}
-	}()
-
-	migrator, err := migrations.NewMigrator(db, postgres.Name)
+	a.Append(app.Hook{Name: "tracing", Stop: shutdownTracing})
+
+	creds, err := mtls.NewCredentials(mtls.Config{
+		CertFile:     cfg.TLS.CertFile,
+		KeyFile:      cfg.TLS.KeyFile,
+		CAFile:       cfg.TLS.CAFile,
+		AllowedPeers: cfg.TLS.AllowedPeers,
+	})
 	if err != nil {
-		slog.Error("migrations.init.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("tls.load.failed", err)
 	}
 
-	defer func() {
-		if closeErr := migrator.Close(); closeErr != nil {
-			slog.Error("migrations.close.failed", slog.String("error", closeErr.Error()))
-		}
-	}()
-
-	if err := migrator.Up(); err != nil {
-		slog.Error("migrations.up.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+	db, err := postgres.Connect(context.Background(), cfg.Postgresql)
+	if err != nil {
+		a.Fatal("postgres.connect.failed", err)
 	}
+	a.Append(app.Closer("postgres", db.Close))
 
-	accountRepository := repository.NewAccountRepository(db, db)
-	accountService := service.NewAccountService(accountRepository)
-	accountGRPCServer := accountHandler.NewGRPCServer(accountService)
-
-	serverErrCh := make(chan error, 1)
-	go func() {
-		slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.AccountPort))
-		serverErrCh <- accountGRPCServer.Serve(cfg.Application.AccountPort)
-	}()
-
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-	select {
-	case sig := <-sigCh:
-		slog.Info("shutdown.signal.received", slog.String("signal", sig.String()))
-	case err := <-serverErrCh:
-		slog.Error("grpc.server.failed", slog.String("error", err.Error()))
+	router, err := postgres.NewRouter(db, cfg.Postgresql)
+	if err != nil {
+		a.Fatal("postgres.replicas.failed", err)
 	}
+	a.Append(app.Closer("postgres.replicas", router.Close))
 
-	slog.Info("shutdown.initiating", slog.String("timeout", "30s"))
+	metrics.RegisterDB(db, cfg.Postgresql.Name)
 
-	if stopErr := accountGRPCServer.Stop(); stopErr != nil {
-		slog.Error("grpc.stop.failed", slog.String("error", stopErr.Error()))
+	if cfg.Application.MigrateOnStart {
+		// The migrator closes the owner's pool when it's closed.
+		owner, err := postgres.ConnectOwner(context.Background(), cfg.Postgresql)
+		if err != nil {
+			a.Fatal("postgres.owner.connect.failed", err)
+		}
+		migrator, err := migrations.NewMigrator(owner, cfg.Postgresql.Name)
+		if err != nil {
+			a.Fatal("migrations.init.failed", err)
+		}
+		a.Append(
+			app.Closer("migrations", migrator.Close),
+			app.Hook{Name: "migrations", Start: migrator.Up},
+		)
 	}
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer shutdownCancel()
-	select {
-	case <-shutdownCtx.Done():
-		slog.Warn("shutdown.timeout.reached")
-	case <-time.After(5 * time.Second):
-		slog.Info("shutdown.drain.complete")
-	}
+	accountRepository := repository.NewAccountRepository(router, router)
+	addressRepository := repository.NewAddressRepository(router, router)
+	accountService := service.NewAccountService(accountRepository)
+	addressService := service.NewAddressService(accountRepository, addressRepository)
+	checker := health.NewChecker(proto.AccountService_ServiceDesc.ServiceName, map[string]health.Check{
+		"postgres": db.PingContext,
+	}, nil)
+	accountGRPCServer := accountHandler.NewGRPCServer(accountService, addressService, checker, cfg.Application.GRPCReflection, creds)
+	metricsServer := metrics.NewServer(cfg.Application.MetricsPort)
+
+	a.Append(
+		app.Hook{
+			Name: "postgres.replicas",
+			Run:  router.Run,
+		},
+		app.Hook{
+			Name: "health",
+			Run: func(ctx context.Context) error {
+				checker.Run(ctx)
+				return nil
+			},
+		},
+		app.Hook{
+			Name: "metrics.server",
+			Run: func(context.Context) error {
+				slog.Info("metrics.server.starting", slog.String("addr", cfg.Application.MetricsPort))
+				if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			},
+			Stop: metricsServer.Shutdown,
+		},
+		app.Hook{
+			Name: "grpc.server",
+			Run: func(context.Context) error {
+				slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.AccountPort))
+				return accountGRPCServer.Serve(cfg.Application.AccountPort)
+			},
+			Stop: func(context.Context) error {
+				return accountGRPCServer.Stop()
+			},
+		},
+	)
 
-	close(sigCh)
-	slog.Info("shutdown.complete")
+	if err := a.Run(context.Background()); err != nil {
+		os.Exit(1)
+	}
 }
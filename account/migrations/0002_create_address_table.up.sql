@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS address (
+    id CHAR(27) PRIMARY KEY,
+    account_id CHAR(27) NOT NULL REFERENCES account (id) ON DELETE CASCADE,
+    recipient VARCHAR(128) NOT NULL,
+    line1 VARCHAR(256) NOT NULL,
+    line2 VARCHAR(256) NOT NULL DEFAULT '',
+    city VARCHAR(128) NOT NULL,
+    region VARCHAR(128) NOT NULL DEFAULT '',
+    postal_code VARCHAR(32) NOT NULL,
+    country CHAR(2) NOT NULL,
+    phone VARCHAR(32) NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS address_account_id_idx ON address (account_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS address_account_default_idx ON address (account_id) WHERE is_default
\ No newline at end of file
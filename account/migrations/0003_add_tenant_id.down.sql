@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS account_tenant_id_idx;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['account', 'address'] LOOP
+        EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', t);
+        EXECUTE format('ALTER TABLE %I NO FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I DISABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I DROP COLUMN IF EXISTS tenant_id', t);
+    END LOOP;
+END $$;
@@ -0,0 +1,18 @@
+-- Rows from before tenants belong to the default tenant. New rows take the
+-- tenant of the session, and the policies hide every other tenant's rows,
+-- from the table owner too. Superusers and BYPASSRLS roles skip them, so
+-- services connect as a role that is neither; see platform/postgres.
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['account', 'address'] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT %L', t, 'default');
+        EXECUTE format('ALTER TABLE %I ALTER COLUMN tenant_id SET DEFAULT NULLIF(current_setting(%L, true), %L)', t, 'app.tenant_id', '');
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = NULLIF(current_setting(%L, true), %L))', t, 'app.tenant_id', '');
+    END LOOP;
+END $$;
+
+CREATE INDEX IF NOT EXISTS account_tenant_id_idx ON account (tenant_id, id);
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"github.com/lib/pq"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
 	"time"
 )
 
@@ -13,11 +15,12 @@ type AccountRepository interface {
 	CreateAccount(ctx context.Context, account *domain.Account) error
 	GetAccountById(ctx context.Context, id string) (*domain.Account, error)
 	GetAccounts(ctx context.Context, accountQuery *dto.AccountQuery) ([]*domain.Account, error)
+	GetAccountsByIds(ctx context.Context, ids []string) ([]*domain.Account, error)
 }
 
 type accountRepository struct {
-	dbWrite *sql.DB
-	dbRead  *sql.DB
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
 }
 
 func (a *accountRepository) CreateAccount(ctx context.Context, account *domain.Account) error {
@@ -67,7 +70,30 @@ func (a *accountRepository) GetAccounts(ctx context.Context, accountQuery *dto.A
 	return accounts, nil
 }
 
-func NewAccountRepository(dbWrite, dbRead *sql.DB) AccountRepository {
+func (a *accountRepository) GetAccountsByIds(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	query := `SELECT id, name FROM account WHERE id = ANY($1)`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	rows, err := a.dbRead.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var accounts []*domain.Account
+	for rows.Next() {
+		var account domain.Account
+		if err := rows.Scan(&account.Id, &account.Name); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, &account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func NewAccountRepository(dbWrite postgres.Writer, dbRead postgres.Reader) AccountRepository {
 	return &accountRepository{
 		dbWrite: dbWrite,
 		dbRead:  dbRead,
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+type AddressRepository interface {
+	CreateAddress(ctx context.Context, address *domain.Address) error
+	GetAddressById(ctx context.Context, id string) (*domain.Address, error)
+	GetAddressesForAccount(ctx context.Context, accountId string) ([]*domain.Address, error)
+	UpdateAddress(ctx context.Context, address *domain.Address) error
+	DeleteAddress(ctx context.Context, id, accountId string) error
+}
+
+type addressRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+func (a *addressRepository) CreateAddress(ctx context.Context, address *domain.Address) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := a.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if address.IsDefault {
+		if err = clearDefault(ctx, tx, address.AccountId); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO address(id, account_id, recipient, line1, line2, city, region, postal_code, country, phone, is_default, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		address.Id,
+		address.AccountId,
+		address.Recipient,
+		address.Line1,
+		address.Line2,
+		address.City,
+		address.Region,
+		address.PostalCode,
+		address.Country,
+		address.Phone,
+		address.IsDefault,
+		address.CreatedAt,
+		address.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (a *addressRepository) GetAddressById(ctx context.Context, id string) (*domain.Address, error) {
+	query := `
+SELECT id, account_id, recipient, line1, line2, city, region, postal_code, country, phone, is_default, created_at, updated_at
+FROM address
+WHERE id = $1`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	address, err := scanAddress(a.dbRead.QueryRowContext(ctx, query, id))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoRows
+		default:
+			return nil, err
+		}
+	}
+	return address, nil
+}
+
+func (a *addressRepository) GetAddressesForAccount(ctx context.Context, accountId string) ([]*domain.Address, error) {
+	query := `
+SELECT id, account_id, recipient, line1, line2, city, region, postal_code, country, phone, is_default, created_at, updated_at
+FROM address
+WHERE account_id = $1
+ORDER BY is_default DESC, created_at, id`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rows, err := a.dbRead.QueryContext(ctx, query, accountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	addresses := make([]*domain.Address, 0)
+	for rows.Next() {
+		address, err := scanAddress(rows)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func (a *addressRepository) UpdateAddress(ctx context.Context, address *domain.Address) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := a.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if address.IsDefault {
+		if err = clearDefault(ctx, tx, address.AccountId); err != nil {
+			return err
+		}
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		`UPDATE address SET recipient=$3, line1=$4, line2=$5, city=$6, region=$7, postal_code=$8, country=$9, phone=$10, is_default=$11, updated_at=$12 WHERE id=$1 AND account_id=$2`,
+		address.Id,
+		address.AccountId,
+		address.Recipient,
+		address.Line1,
+		address.Line2,
+		address.City,
+		address.Region,
+		address.PostalCode,
+		address.Country,
+		address.Phone,
+		address.IsDefault,
+		address.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if err = checkAffected(res); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (a *addressRepository) DeleteAddress(ctx context.Context, id, accountId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := a.dbWrite.ExecContext(ctx, `DELETE FROM address WHERE id=$1 AND account_id=$2`, id, accountId)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// clearDefault unsets the current default address of the account so that
+// another one can take its place.
+func clearDefault(ctx context.Context, tx *sql.Tx, accountId string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE address SET is_default=FALSE WHERE account_id=$1 AND is_default`, accountId)
+	return err
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoRows
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAddress(row rowScanner) (*domain.Address, error) {
+	var address domain.Address
+	if err := row.Scan(
+		&address.Id,
+		&address.AccountId,
+		&address.Recipient,
+		&address.Line1,
+		&address.Line2,
+		&address.City,
+		&address.Region,
+		&address.PostalCode,
+		&address.Country,
+		&address.Phone,
+		&address.IsDefault,
+		&address.CreatedAt,
+		&address.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+func NewAddressRepository(dbWrite postgres.Writer, dbRead postgres.Reader) AddressRepository {
+	return &addressRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
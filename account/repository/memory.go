@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"slices"
+	"sync"
+)
+
+// memoryAccountRepository keeps accounts in a map, for tests and local runs
+// without Postgres. It returns copies so callers can't change what it holds.
+// Like row-level security, it hides the accounts of other tenants, and of
+// every tenant from a context without one, which can't create any either.
+type memoryAccountRepository struct {
+	mu       sync.RWMutex
+	accounts map[string]memoryAccount
+}
+
+type memoryAccount struct {
+	domain.Account
+	tenantId string
+}
+
+func (m *memoryAccountRepository) CreateAccount(ctx context.Context, account *domain.Account) error {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return tenant.ErrMissing
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.accounts[account.Id]; ok {
+		return fmt.Errorf("account %s already exists", account.Id)
+	}
+	m.accounts[account.Id] = memoryAccount{Account: *account, tenantId: tenantId}
+	return nil
+}
+
+// get returns account id if it belongs to the tenant of ctx.
+func (m *memoryAccountRepository) get(ctx context.Context, id string) (domain.Account, bool) {
+	account, ok := m.accounts[id]
+	if !ok || account.tenantId != tenant.FromContext(ctx) {
+		return domain.Account{}, false
+	}
+	return account.Account, true
+}
+
+func (m *memoryAccountRepository) GetAccountById(ctx context.Context, id string) (*domain.Account, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	account, ok := m.get(ctx, id)
+	if !ok {
+		return nil, ErrNoRows
+	}
+	return &account, nil
+}
+
+func (m *memoryAccountRepository) GetAccounts(ctx context.Context, accountQuery *dto.AccountQuery) ([]*domain.Account, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tenantId := tenant.FromContext(ctx)
+	accounts := make([]*domain.Account, 0, len(m.accounts))
+	for _, account := range m.accounts {
+		if account.tenantId == tenantId {
+			accounts = append(accounts, &account.Account)
+		}
+	}
+	// Same order as the Postgres repository: id descending.
+	slices.SortFunc(accounts, func(a, b *domain.Account) int {
+		return cmp.Compare(b.Id, a.Id)
+	})
+	offset := min(accountQuery.Offset, uint64(len(accounts)))
+	end := min(offset+accountQuery.Limit, uint64(len(accounts)))
+	return accounts[offset:end], nil
+}
+
+func (m *memoryAccountRepository) GetAccountsByIds(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var accounts []*domain.Account
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if account, ok := m.get(ctx, id); ok && !seen[id] {
+			seen[id] = true
+			accounts = append(accounts, &account)
+		}
+	}
+	return accounts, nil
+}
+
+func NewMemoryAccountRepository() AccountRepository {
+	return &memoryAccountRepository{
+		accounts: make(map[string]memoryAccount),
+	}
+}
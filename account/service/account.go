@@ -2,16 +2,20 @@ package service
 
 import (
 	"context"
+	"errors"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
 	"github.com/segmentio/ksuid"
 )
 
+var ErrTooManyIds = errors.New("invalid input: too many IDs")
+
 type AccountService interface {
 	CreateAccount(ctx context.Context, input *dto.Account) (*domain.Account, error)
 	GetAccountById(ctx context.Context, id string) (*domain.Account, error)
 	GetAccounts(ctx context.Context, input *dto.AccountQuery) ([]*domain.Account, error)
+	GetAccountsByIds(ctx context.Context, ids []string) ([]*domain.Account, error)
 }
 
 type accountService struct {
@@ -40,6 +44,16 @@ func (a *accountService) GetAccounts(ctx context.Context, input *dto.AccountQuer
 	return a.accountRepository.GetAccounts(ctx, input)
 }
 
+func (a *accountService) GetAccountsByIds(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	if len(ids) == 0 {
+		return []*domain.Account{}, nil
+	}
+	if len(ids) > 100 {
+		return nil, ErrTooManyIds
+	}
+	return a.accountRepository.GetAccountsByIds(ctx, ids)
+}
+
 func NewAccountService(accountRepository repository.AccountRepository) AccountService {
 	return &accountService{
 		accountRepository: accountRepository,
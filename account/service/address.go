@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
+	"github.com/segmentio/ksuid"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidAddress = errors.New("invalid input: recipient, line1, city, postal code and country are required")
+	ErrInvalidCountry = errors.New("invalid input: country must be an ISO 3166-1 alpha-2 code")
+)
+
+type AddressService interface {
+	CreateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error)
+	GetAddressById(ctx context.Context, id string) (*domain.Address, error)
+	GetAddressesForAccount(ctx context.Context, accountId string) ([]*domain.Address, error)
+	UpdateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error)
+	DeleteAddress(ctx context.Context, id, accountId string) error
+}
+
+type addressService struct {
+	accountRepository repository.AccountRepository
+	addressRepository repository.AddressRepository
+}
+
+// CreateAddress adds an address to the account's address book. The first
+// address of an account becomes its default.
+func (a *addressService) CreateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error) {
+	if err := validateAddress(input); err != nil {
+		return nil, err
+	}
+	if _, err := a.accountRepository.GetAccountById(ctx, input.AccountId); err != nil {
+		return nil, err
+	}
+
+	existing, err := a.addressRepository.GetAddressesForAccount(ctx, input.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	address := toAddress(input)
+	address.Id = ksuid.New().String()
+	address.IsDefault = input.IsDefault || len(existing) == 0
+	address.CreatedAt = now
+	address.UpdatedAt = now
+	if err := a.addressRepository.CreateAddress(ctx, address); err != nil {
+		return nil, err
+	}
+	return address, nil
+}
+
+func (a *addressService) GetAddressById(ctx context.Context, id string) (*domain.Address, error) {
+	return a.addressRepository.GetAddressById(ctx, id)
+}
+
+func (a *addressService) GetAddressesForAccount(ctx context.Context, accountId string) ([]*domain.Address, error) {
+	return a.addressRepository.GetAddressesForAccount(ctx, accountId)
+}
+
+func (a *addressService) UpdateAddress(ctx context.Context, input *dto.Address) (*domain.Address, error) {
+	if err := validateAddress(input); err != nil {
+		return nil, err
+	}
+
+	current, err := a.addressRepository.GetAddressById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if current.AccountId != input.AccountId {
+		return nil, repository.ErrNoRows
+	}
+
+	address := toAddress(input)
+	address.Id = current.Id
+	address.IsDefault = input.IsDefault || current.IsDefault
+	address.CreatedAt = current.CreatedAt
+	address.UpdatedAt = time.Now().UTC()
+	if err := a.addressRepository.UpdateAddress(ctx, address); err != nil {
+		return nil, err
+	}
+	return address, nil
+}
+
+func (a *addressService) DeleteAddress(ctx context.Context, id, accountId string) error {
+	return a.addressRepository.DeleteAddress(ctx, id, accountId)
+}
+
+func validateAddress(input *dto.Address) error {
+	if strings.TrimSpace(input.Recipient) == "" ||
+		strings.TrimSpace(input.Line1) == "" ||
+		strings.TrimSpace(input.City) == "" ||
+		strings.TrimSpace(input.PostalCode) == "" {
+		return ErrInvalidAddress
+	}
+	if len(strings.TrimSpace(input.Country)) != 2 {
+		return ErrInvalidCountry
+	}
+	return nil
+}
+
+func toAddress(input *dto.Address) *domain.Address {
+	return &domain.Address{
+		AccountId:  input.AccountId,
+		Recipient:  strings.TrimSpace(input.Recipient),
+		Line1:      strings.TrimSpace(input.Line1),
+		Line2:      strings.TrimSpace(input.Line2),
+		City:       strings.TrimSpace(input.City),
+		Region:     strings.TrimSpace(input.Region),
+		PostalCode: strings.TrimSpace(input.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(input.Country)),
+		Phone:      strings.TrimSpace(input.Phone),
+	}
+}
+
+func NewAddressService(accountRepository repository.AccountRepository, addressRepository repository.AddressRepository) AddressService {
+	return &addressService{
+		accountRepository: accountRepository,
+		addressRepository: addressRepository,
+	}
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+type Application struct {
+	CartPort        string        `env:"CART_PORT"`
+	CatalogPort     string        `env:"CATALOG_PORT"`
+	OrderPort       string        `env:"ORDER_PORT"`
+	GRPCReflection  bool          `env:"GRPC_REFLECTION"`
+	MigrateOnStart  bool          `env:"MIGRATE_ON_START" envDefault:"true"`
+	MetricsPort     string        `env:"METRICS_PORT" envDefault:":9104"`
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"30s"`
+}
+
+func (a *Application) Validate() error {
+	var errs []error
+	if a.CartPort == "" {
+		errs = append(errs, errors.New("CART_PORT is required"))
+	}
+	if a.CatalogPort == "" {
+		errs = append(errs, errors.New("CATALOG_PORT is required"))
+	}
+	if a.OrderPort == "" {
+		errs = append(errs, errors.New("ORDER_PORT is required"))
+	}
+	if a.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT must be positive"))
+	}
+	return errors.Join(errs...)
+}
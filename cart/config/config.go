@@ -0,0 +1,32 @@
+package config
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/configx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"sync"
+)
+
+var (
+	instance *Config
+	once     sync.Once
+	initErr  error
+)
+
+type Config struct {
+	Application Application
+	Postgresql  postgres.Config `envPrefix:"CART_POSTGRES_"`
+	Tracing     Tracing
+	TLS         TLS
+	Resilience  Resilience
+}
+
+func NewConfig() (*Config, error) {
+	once.Do(func() {
+		instance = &Config{}
+		initErr = configx.Load(instance)
+		if initErr != nil {
+			instance = nil
+		}
+	})
+	return instance, initErr
+}
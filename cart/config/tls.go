@@ -0,0 +1,8 @@
+package config
+
+type TLS struct {
+	CertFile     string   `env:"TLS_CERT_FILE"`
+	KeyFile      string   `env:"TLS_KEY_FILE"`
+	CAFile       string   `env:"TLS_CA_FILE"`
+	AllowedPeers []string `env:"TLS_ALLOWED_PEERS" envSeparator:","`
+}
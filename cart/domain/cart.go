@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+type Cart struct {
+	Id         string      `json:"id"`
+	AccountId  string      `json:"account_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+	TotalPrice float64     `json:"total_price"`
+	Items      []*CartItem `json:"items"`
+}
+
+type CartItem struct {
+	CatalogId   string  `json:"catalog_id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Quantity    uint32  `json:"quantity"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type CartItem struct {
+	CartId      string  `json:"cart_id"`
+	CatalogId   string  `json:"catalog_id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Quantity    uint32  `json:"quantity"`
+}
+
+type MergeCarts struct {
+	GuestCartId string `json:"guest_cart_id"`
+	AccountId   string `json:"account_id"`
+}
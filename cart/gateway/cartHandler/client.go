@@ -0,0 +1,225 @@
+package cartHandler
+
+import (
+	"context"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/gateway/proto"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
+	"google.golang.org/grpc"
+	"time"
+)
+
+type GRPCCartClient interface {
+	CreateCart(ctx context.Context, accountId string) (*domain.Cart, error)
+	GetCart(ctx context.Context, id string) (*domain.Cart, error)
+	GetCartForAccount(ctx context.Context, accountId string) (*domain.Cart, error)
+	AddCartItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error)
+	UpdateCartItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error)
+	RemoveCartItem(ctx context.Context, cartId, catalogId string) (*domain.Cart, error)
+	MergeCarts(ctx context.Context, input *dto.MergeCarts) (*domain.Cart, error)
+	RefreshCart(ctx context.Context, cartId string) (*domain.Cart, error)
+	CheckoutCart(ctx context.Context, cartId, shippingAddressId string) (*orderDomain.Order, error)
+	Close() error
+}
+
+type gRPCCartClient struct {
+	conn   *grpc.ClientConn
+	client proto.CartServiceClient
+}
+
+func (g *gRPCCartClient) CreateCart(ctx context.Context, accountId string) (*domain.Cart, error) {
+	resp, err := g.client.CreateCart(ctx, &proto.CreateCartRequest{AccountId: accountId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) GetCart(ctx context.Context, id string) (*domain.Cart, error) {
+	resp, err := g.client.GetCart(ctx, &proto.GetCartRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) GetCartForAccount(ctx context.Context, accountId string) (*domain.Cart, error) {
+	resp, err := g.client.GetCartForAccount(ctx, &proto.GetCartForAccountRequest{AccountId: accountId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) AddCartItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error) {
+	resp, err := g.client.AddCartItem(ctx, &proto.AddCartItemRequest{
+		CartId:    input.CartId,
+		CatalogId: input.CatalogId,
+		Quantity:  input.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) UpdateCartItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error) {
+	resp, err := g.client.UpdateCartItem(ctx, &proto.UpdateCartItemRequest{
+		CartId:    input.CartId,
+		CatalogId: input.CatalogId,
+		Quantity:  input.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) RemoveCartItem(ctx context.Context, cartId, catalogId string) (*domain.Cart, error) {
+	resp, err := g.client.RemoveCartItem(ctx, &proto.RemoveCartItemRequest{
+		CartId:    cartId,
+		CatalogId: catalogId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) MergeCarts(ctx context.Context, input *dto.MergeCarts) (*domain.Cart, error) {
+	resp, err := g.client.MergeCarts(ctx, &proto.MergeCartsRequest{
+		GuestCartId: input.GuestCartId,
+		AccountId:   input.AccountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) RefreshCart(ctx context.Context, cartId string) (*domain.Cart, error) {
+	resp, err := g.client.RefreshCart(ctx, &proto.RefreshCartRequest{CartId: cartId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCart(resp.Cart)
+}
+
+func (g *gRPCCartClient) CheckoutCart(ctx context.Context, cartId, shippingAddressId string) (*orderDomain.Order, error) {
+	resp, err := g.client.CheckoutCart(ctx, &proto.CheckoutCartRequest{
+		CartId:            cartId,
+		ShippingAddressId: shippingAddressId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var createdAt time.Time
+	if len(resp.Order.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(resp.Order.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	catalogs := make([]*orderDomain.OrderedCatalog, len(resp.Order.Catalogs))
+	for i, c := range resp.Order.Catalogs {
+		catalogs[i] = &orderDomain.OrderedCatalog{
+			Id:          c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.Price,
+			Quantity:    c.Quantity,
+		}
+	}
+
+	var shippingAddress *orderDomain.ShippingAddress
+	if a := resp.Order.ShippingAddress; a != nil {
+		shippingAddress = &orderDomain.ShippingAddress{
+			Recipient:  a.Recipient,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+		}
+	}
+
+	return &orderDomain.Order{
+		Id:              resp.Order.Id,
+		CreatedAt:       createdAt,
+		TotalPrice:      resp.Order.TotalPrice,
+		AccountId:       resp.Order.AccountId,
+		Status:          resp.Order.Status,
+		ShippingAddress: shippingAddress,
+		Catalogs:        catalogs,
+	}, nil
+}
+
+func (g *gRPCCartClient) Close() error {
+	return g.conn.Close()
+}
+
+func toDomainCart(c *proto.Cart) (*domain.Cart, error) {
+	var createdAt, updatedAt time.Time
+	if len(c.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(c.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.UpdatedAt) > 0 {
+		if err := updatedAt.UnmarshalBinary(c.UpdatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	items := make([]*domain.CartItem, len(c.Items))
+	for i, item := range c.Items {
+		items[i] = &domain.CartItem{
+			CatalogId:   item.CatalogId,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			Quantity:    item.Quantity,
+		}
+	}
+
+	return &domain.Cart{
+		Id:         c.Id,
+		AccountId:  c.AccountId,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+		TotalPrice: c.TotalPrice,
+		Items:      items,
+	}, nil
+}
+
+var cartReads = []string{"GetCart", "GetCartForAccount"}
+
+func NewGRPCCartClient(addr string, creds *mtls.Credentials, retry grpcx.RetryPolicy, breaker *grpcx.Breaker, dialOpts ...grpc.DialOption) (GRPCCartClient, error) {
+	opts := []grpc.DialOption{
+		creds.DialOption(),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), postgres.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor()),
+	}
+	opts = append(opts, grpcx.DialOptions()...)
+	opts = append(opts, grpcx.ResilienceOptions(proto.CartService_ServiceDesc.ServiceName, cartReads, retry, breaker)...)
+	opts = append(opts, dialOpts...)
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cartServiceClient := proto.NewCartServiceClient(conn)
+	return &gRPCCartClient{
+		conn:   conn,
+		client: cartServiceClient,
+	}, nil
+}
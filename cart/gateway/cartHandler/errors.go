@@ -0,0 +1,16 @@
+package cartHandler
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"google.golang.org/grpc/codes"
+)
+
+var errorMapper = grpcx.NewErrorMapper("cart",
+	grpcx.ErrorRule{Err: repository.ErrNoRows, Code: codes.NotFound, Reason: "CART_NOT_FOUND"},
+	grpcx.ErrorRule{Err: service.ErrInvalidQuantity, Code: codes.InvalidArgument, Reason: "INVALID_QUANTITY", Field: "quantity"},
+	grpcx.ErrorRule{Err: service.ErrNotGuestCart, Code: codes.FailedPrecondition, Reason: "CART_NOT_GUEST"},
+	grpcx.ErrorRule{Err: service.ErrEmptyCart, Code: codes.FailedPrecondition, Reason: "CART_EMPTY"},
+	grpcx.ErrorRule{Err: service.ErrGuestCheckout, Code: codes.FailedPrecondition, Reason: "GUEST_CHECKOUT"},
+)
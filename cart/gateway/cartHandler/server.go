@@ -0,0 +1,352 @@
+package cartHandler
+
+import (
+	"context"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/service"
+	catalogDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"net"
+)
+
+type GRPCCartServer interface {
+	CreateCart(ctx context.Context, req *proto.CreateCartRequest) (*proto.CreateCartResponse, error)
+	GetCart(ctx context.Context, req *proto.GetCartRequest) (*proto.GetCartResponse, error)
+	GetCartForAccount(ctx context.Context, req *proto.GetCartForAccountRequest) (*proto.GetCartResponse, error)
+	AddCartItem(ctx context.Context, req *proto.AddCartItemRequest) (*proto.CartResponse, error)
+	UpdateCartItem(ctx context.Context, req *proto.UpdateCartItemRequest) (*proto.CartResponse, error)
+	RemoveCartItem(ctx context.Context, req *proto.RemoveCartItemRequest) (*proto.CartResponse, error)
+	MergeCarts(ctx context.Context, req *proto.MergeCartsRequest) (*proto.CartResponse, error)
+	RefreshCart(ctx context.Context, req *proto.RefreshCartRequest) (*proto.CartResponse, error)
+	CheckoutCart(ctx context.Context, req *proto.CheckoutCartRequest) (*proto.CheckoutCartResponse, error)
+	Serve(addr string) error
+	ServeListener(lis net.Listener) error
+	Stop() error
+}
+
+type gRPCCartServer struct {
+	cartService   service.CartService
+	catalogClient catalogHandler.GRPCCatalogClient
+	orderClient   orderHandler.GRPCOrderClient
+	checker       *health.Checker
+	reflection    bool
+	creds         *mtls.Credentials
+	server        *grpc.Server
+	proto.UnimplementedCartServiceServer
+}
+
+func (g *gRPCCartServer) CreateCart(ctx context.Context, req *proto.CreateCartRequest) (*proto.CreateCartResponse, error) {
+	cart, err := g.cartService.CreateCart(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CreateCartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) GetCart(ctx context.Context, req *proto.GetCartRequest) (*proto.GetCartResponse, error) {
+	cart, err := g.cartService.GetCartById(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetCartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) GetCartForAccount(ctx context.Context, req *proto.GetCartForAccountRequest) (*proto.GetCartResponse, error) {
+	cart, err := g.cartService.GetCartForAccount(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetCartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) AddCartItem(ctx context.Context, req *proto.AddCartItemRequest) (*proto.CartResponse, error) {
+	catalog, err := g.catalogClient.GetCatalogById(ctx, req.CatalogId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch catalog details: %w", err)
+	}
+
+	cart, err := g.cartService.AddItem(ctx, &dto.CartItem{
+		CartId:      req.CartId,
+		CatalogId:   catalog.Id,
+		Name:        catalog.Name,
+		Description: catalog.Description,
+		Price:       catalog.Price,
+		Quantity:    req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) UpdateCartItem(ctx context.Context, req *proto.UpdateCartItemRequest) (*proto.CartResponse, error) {
+	item := &dto.CartItem{
+		CartId:    req.CartId,
+		CatalogId: req.CatalogId,
+		Quantity:  req.Quantity,
+	}
+	if req.Quantity > 0 {
+		catalog, err := g.catalogClient.GetCatalogById(ctx, req.CatalogId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch catalog details: %w", err)
+		}
+		item.Name = catalog.Name
+		item.Description = catalog.Description
+		item.Price = catalog.Price
+	}
+
+	cart, err := g.cartService.UpdateItem(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) RemoveCartItem(ctx context.Context, req *proto.RemoveCartItemRequest) (*proto.CartResponse, error) {
+	cart, err := g.cartService.RemoveItem(ctx, req.CartId, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) MergeCarts(ctx context.Context, req *proto.MergeCartsRequest) (*proto.CartResponse, error) {
+	cart, err := g.cartService.MergeCarts(ctx, &dto.MergeCarts{
+		GuestCartId: req.GuestCartId,
+		AccountId:   req.AccountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err = g.refresh(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) RefreshCart(ctx context.Context, req *proto.RefreshCartRequest) (*proto.CartResponse, error) {
+	cart, err := g.cartService.GetCartById(ctx, req.CartId)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err = g.refresh(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CartResponse{
+		Cart: toProtoCart(cart),
+	}, nil
+}
+
+func (g *gRPCCartServer) CheckoutCart(ctx context.Context, req *proto.CheckoutCartRequest) (*proto.CheckoutCartResponse, error) {
+	// 1. Load the cart and make sure it can be checked out
+	cart, err := g.cartService.GetCartById(ctx, req.CartId)
+	if err != nil {
+		return nil, err
+	}
+	if cart.AccountId == "" {
+		return nil, service.ErrGuestCheckout
+	}
+
+	// 2. Refresh prices so the cart matches what the order will charge
+	cart, err = g.refresh(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, service.ErrEmptyCart
+	}
+
+	// 3. Create the order through the order service. The checkout key stays
+	// the same until the cart is cleared or its lines change, so a retry
+	// after a failure below gets back the order placed here instead of a
+	// second one.
+	checkoutKey, err := g.cartService.StartCheckout(ctx, cart.Id)
+	if err != nil {
+		return nil, err
+	}
+	orderedCatalogs := make([]*orderDTO.OrderedCatalog, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		orderedCatalogs = append(orderedCatalogs, &orderDTO.OrderedCatalog{
+			Id:       item.CatalogId,
+			Quantity: item.Quantity,
+		})
+	}
+	order, err := g.orderClient.CreateOrder(ctx, &orderDTO.Order{
+		AccountId:         cart.AccountId,
+		ShippingAddressId: req.ShippingAddressId,
+		CheckoutKey:       checkoutKey,
+		Catalogs:          orderedCatalogs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create order: %w", err)
+	}
+
+	// 4. Empty the cart now that its lines are ordered
+	if err := g.cartService.ClearCart(ctx, cart.Id); err != nil {
+		return nil, err
+	}
+
+	orderProto := &proto.CheckoutOrder{
+		Id:         order.Id,
+		AccountId:  order.AccountId,
+		TotalPrice: order.TotalPrice,
+		Status:     order.Status,
+		Catalogs:   []*proto.CheckoutOrder_OrderCatalog{},
+	}
+	orderProto.CreatedAt, _ = order.CreatedAt.MarshalBinary()
+	if a := order.ShippingAddress; a != nil {
+		orderProto.ShippingAddress = &proto.ShippingAddress{
+			Recipient:  a.Recipient,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+		}
+	}
+
+	for _, c := range order.Catalogs {
+		orderProto.Catalogs = append(orderProto.Catalogs, &proto.CheckoutOrder_OrderCatalog{
+			Id:          c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.Price,
+			Quantity:    c.Quantity,
+		})
+	}
+
+	return &proto.CheckoutCartResponse{
+		Order: orderProto,
+	}, nil
+}
+
+func (g *gRPCCartServer) refresh(ctx context.Context, cart *domain.Cart) (*domain.Cart, error) {
+	if len(cart.Items) == 0 {
+		return cart, nil
+	}
+
+	catalogIds := make([]string, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		catalogIds = append(catalogIds, item.CatalogId)
+	}
+
+	catalogs, err := g.catalogClient.GetCatalogs(ctx, &catalogDTO.CatalogQuery{
+		Ids: catalogIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch catalog details: %w", err)
+	}
+
+	items := make([]*dto.CartItem, 0, len(catalogs))
+	for _, c := range catalogs {
+		items = append(items, &dto.CartItem{
+			CartId:      cart.Id,
+			CatalogId:   c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.Price,
+		})
+	}
+	return g.cartService.RefreshCart(ctx, cart.Id, items)
+}
+
+func (g *gRPCCartServer) Serve(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return g.ServeListener(lis)
+}
+
+// ServeListener serves on lis until the server stops, closing it then.
+func (g *gRPCCartServer) ServeListener(lis net.Listener) error {
+	opts := g.creds.ServerOptions()
+	opts = append(opts,
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), postgres.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), postgres.StreamServerInterceptor()),
+	)
+	opts = append(opts, grpcx.ServerOptions(errorMapper)...)
+	g.server = grpc.NewServer(opts...)
+	proto.RegisterCartServiceServer(g.server, g)
+	g.checker.Register(g.server)
+	metrics.InitializeServer(g.server)
+	if g.reflection {
+		reflection.Register(g.server)
+	}
+	return g.server.Serve(lis)
+}
+
+func (g *gRPCCartServer) Stop() error {
+	g.checker.Shutdown()
+	if g.server != nil {
+		g.server.GracefulStop()
+	}
+	return nil
+}
+
+func toProtoCart(cart *domain.Cart) *proto.Cart {
+	cartProto := &proto.Cart{
+		Id:         cart.Id,
+		AccountId:  cart.AccountId,
+		TotalPrice: cart.TotalPrice,
+		Items:      make([]*proto.Cart_CartItem, 0, len(cart.Items)),
+	}
+	cartProto.CreatedAt, _ = cart.CreatedAt.MarshalBinary()
+	cartProto.UpdatedAt, _ = cart.UpdatedAt.MarshalBinary()
+
+	for _, item := range cart.Items {
+		cartProto.Items = append(cartProto.Items, &proto.Cart_CartItem{
+			CatalogId:   item.CatalogId,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			Quantity:    item.Quantity,
+		})
+	}
+	return cartProto
+}
+
+func NewGRPCCartServer(cartService service.CartService, catalogClient catalogHandler.GRPCCatalogClient, orderClient orderHandler.GRPCOrderClient, checker *health.Checker, reflection bool, creds *mtls.Credentials) GRPCCartServer {
+	return &gRPCCartServer{
+		cartService:   cartService,
+		catalogClient: catalogClient,
+		orderClient:   orderClient,
+		checker:       checker,
+		reflection:    reflection,
+		creds:         creds,
+	}
+}
@@ -0,0 +1,1264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v3.21.12
+// source: gateway/proto/cart.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Cart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	CreatedAt     []byte                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     []byte                 `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,5,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Items         []*Cart_CartItem       `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cart) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Cart) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Cart) GetCreatedAt() []byte {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Cart) GetUpdatedAt() []byte {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Cart) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Cart) GetItems() []*Cart_CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckoutOrder struct {
+	state           protoimpl.MessageState        `protogen:"open.v1"`
+	Id              string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       []byte                        `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	AccountId       string                        `protobuf:"bytes,3,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	TotalPrice      float64                       `protobuf:"fixed64,4,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Catalogs        []*CheckoutOrder_OrderCatalog `protobuf:"bytes,5,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	Status          string                        `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ShippingAddress *ShippingAddress              `protobuf:"bytes,7,opt,name=shippingAddress,proto3" json:"shippingAddress,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckoutOrder) Reset() {
+	*x = CheckoutOrder{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutOrder) ProtoMessage() {}
+
+func (x *CheckoutOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutOrder.ProtoReflect.Descriptor instead.
+func (*CheckoutOrder) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckoutOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetCreatedAt() []byte {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetCatalogs() []*CheckoutOrder_OrderCatalog {
+	if x != nil {
+		return x.Catalogs
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+type ShippingAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1         string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,6,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Phone         string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingAddress) Reset() {
+	*x = ShippingAddress{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingAddress) ProtoMessage() {}
+
+func (x *ShippingAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingAddress.ProtoReflect.Descriptor instead.
+func (*ShippingAddress) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShippingAddress) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type CreateCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCartRequest) Reset() {
+	*x = CreateCartRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCartRequest) ProtoMessage() {}
+
+func (x *CreateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateCartRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCartRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type CreateCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCartResponse) Reset() {
+	*x = CreateCartResponse{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCartResponse) ProtoMessage() {}
+
+func (x *CreateCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateCartResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type GetCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRequest) ProtoMessage() {}
+
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCartForAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartForAccountRequest) Reset() {
+	*x = GetCartForAccountRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartForAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartForAccountRequest) ProtoMessage() {}
+
+func (x *GetCartForAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartForAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetCartForAccountRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCartForAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartResponse) Reset() {
+	*x = GetCartResponse{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartResponse) ProtoMessage() {}
+
+func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
+func (*GetCartResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type AddCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cartId,proto3" json:"cartId,omitempty"`
+	CatalogId     string                 `protobuf:"bytes,2,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCartItemRequest) Reset() {
+	*x = AddCartItemRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCartItemRequest) ProtoMessage() {}
+
+func (x *AddCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCartItemRequest.ProtoReflect.Descriptor instead.
+func (*AddCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddCartItemRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *AddCartItemRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *AddCartItemRequest) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type UpdateCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cartId,proto3" json:"cartId,omitempty"`
+	CatalogId     string                 `protobuf:"bytes,2,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCartItemRequest) Reset() {
+	*x = UpdateCartItemRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCartItemRequest) ProtoMessage() {}
+
+func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCartItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateCartItemRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *UpdateCartItemRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *UpdateCartItemRequest) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RemoveCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cartId,proto3" json:"cartId,omitempty"`
+	CatalogId     string                 `protobuf:"bytes,2,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemRequest) Reset() {
+	*x = RemoveCartItemRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemRequest) ProtoMessage() {}
+
+func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveCartItemRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *RemoveCartItemRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+type MergeCartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestCartId   string                 `protobuf:"bytes,1,opt,name=guestCartId,proto3" json:"guestCartId,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsRequest) ProtoMessage() {}
+
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MergeCartsRequest) GetGuestCartId() string {
+	if x != nil {
+		return x.GuestCartId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type RefreshCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cartId,proto3" json:"cartId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCartRequest) Reset() {
+	*x = RefreshCartRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCartRequest) ProtoMessage() {}
+
+func (x *RefreshCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCartRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCartRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefreshCartRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+type CartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartResponse) Reset() {
+	*x = CartResponse{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartResponse) ProtoMessage() {}
+
+func (x *CartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
+func (*CartResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type CheckoutCartRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CartId            string                 `protobuf:"bytes,1,opt,name=cartId,proto3" json:"cartId,omitempty"`
+	ShippingAddressId string                 `protobuf:"bytes,2,opt,name=shippingAddressId,proto3" json:"shippingAddressId,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckoutCartRequest) Reset() {
+	*x = CheckoutCartRequest{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartRequest) ProtoMessage() {}
+
+func (x *CheckoutCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutCartRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckoutCartRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *CheckoutCartRequest) GetShippingAddressId() string {
+	if x != nil {
+		return x.ShippingAddressId
+	}
+	return ""
+}
+
+type CheckoutCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *CheckoutOrder         `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutCartResponse) Reset() {
+	*x = CheckoutCartResponse{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartResponse) ProtoMessage() {}
+
+func (x *CheckoutCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutCartResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckoutCartResponse) GetOrder() *CheckoutOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type Cart_CartItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cart_CartItem) Reset() {
+	*x = Cart_CartItem{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart_CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart_CartItem) ProtoMessage() {}
+
+func (x *Cart_CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart_CartItem.ProtoReflect.Descriptor instead.
+func (*Cart_CartItem) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Cart_CartItem) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *Cart_CartItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cart_CartItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Cart_CartItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Cart_CartItem) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CheckoutOrder_OrderCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutOrder_OrderCatalog) Reset() {
+	*x = CheckoutOrder_OrderCatalog{}
+	mi := &file_gateway_proto_cart_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutOrder_OrderCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutOrder_OrderCatalog) ProtoMessage() {}
+
+func (x *CheckoutOrder_OrderCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_cart_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutOrder_OrderCatalog.ProtoReflect.Descriptor instead.
+func (*CheckoutOrder_OrderCatalog) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_cart_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *CheckoutOrder_OrderCatalog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutOrder_OrderCatalog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckoutOrder_OrderCatalog) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CheckoutOrder_OrderCatalog) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CheckoutOrder_OrderCatalog) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+var File_gateway_proto_cart_proto protoreflect.FileDescriptor
+
+const file_gateway_proto_cart_proto_rawDesc = "" +
+	"\n" +
+	"\x18gateway/proto/cart.proto\x12\x04cart\"\xce\x02\n" +
+	"\x04Cart\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\x12\x1c\n" +
+	"\tcreatedAt\x18\x03 \x01(\fR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x04 \x01(\fR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"totalPrice\x18\x05 \x01(\x01R\n" +
+	"totalPrice\x12)\n" +
+	"\x05items\x18\x06 \x03(\v2\x13.cart.Cart.CartItemR\x05items\x1a\x90\x01\n" +
+	"\bCartItem\x12\x1c\n" +
+	"\tcatalogId\x18\x01 \x01(\tR\tcatalogId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\rR\bquantity\"\x9b\x03\n" +
+	"\rCheckoutOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcreatedAt\x18\x02 \x01(\fR\tcreatedAt\x12\x1c\n" +
+	"\taccountId\x18\x03 \x01(\tR\taccountId\x12\x1e\n" +
+	"\n" +
+	"totalPrice\x18\x04 \x01(\x01R\n" +
+	"totalPrice\x12<\n" +
+	"\bcatalogs\x18\x05 \x03(\v2 .cart.CheckoutOrder.OrderCatalogR\bcatalogs\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12?\n" +
+	"\x0fshippingAddress\x18\a \x01(\v2\x15.cart.ShippingAddressR\x0fshippingAddress\x1a\x86\x01\n" +
+	"\fOrderCatalog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\rR\bquantity\"\xd7\x01\n" +
+	"\x0fShippingAddress\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"postalCode\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\"1\n" +
+	"\x11CreateCartRequest\x12\x1c\n" +
+	"\taccountId\x18\x01 \x01(\tR\taccountId\"4\n" +
+	"\x12CreateCartResponse\x12\x1e\n" +
+	"\x04cart\x18\x01 \x01(\v2\n" +
+	".cart.CartR\x04cart\" \n" +
+	"\x0eGetCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x18GetCartForAccountRequest\x12\x1c\n" +
+	"\taccountId\x18\x01 \x01(\tR\taccountId\"1\n" +
+	"\x0fGetCartResponse\x12\x1e\n" +
+	"\x04cart\x18\x01 \x01(\v2\n" +
+	".cart.CartR\x04cart\"f\n" +
+	"\x12AddCartItemRequest\x12\x16\n" +
+	"\x06cartId\x18\x01 \x01(\tR\x06cartId\x12\x1c\n" +
+	"\tcatalogId\x18\x02 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\"i\n" +
+	"\x15UpdateCartItemRequest\x12\x16\n" +
+	"\x06cartId\x18\x01 \x01(\tR\x06cartId\x12\x1c\n" +
+	"\tcatalogId\x18\x02 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\"M\n" +
+	"\x15RemoveCartItemRequest\x12\x16\n" +
+	"\x06cartId\x18\x01 \x01(\tR\x06cartId\x12\x1c\n" +
+	"\tcatalogId\x18\x02 \x01(\tR\tcatalogId\"S\n" +
+	"\x11MergeCartsRequest\x12 \n" +
+	"\vguestCartId\x18\x01 \x01(\tR\vguestCartId\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\",\n" +
+	"\x12RefreshCartRequest\x12\x16\n" +
+	"\x06cartId\x18\x01 \x01(\tR\x06cartId\".\n" +
+	"\fCartResponse\x12\x1e\n" +
+	"\x04cart\x18\x01 \x01(\v2\n" +
+	".cart.CartR\x04cart\"[\n" +
+	"\x13CheckoutCartRequest\x12\x16\n" +
+	"\x06cartId\x18\x01 \x01(\tR\x06cartId\x12,\n" +
+	"\x11shippingAddressId\x18\x02 \x01(\tR\x11shippingAddressId\"A\n" +
+	"\x14CheckoutCartResponse\x12)\n" +
+	"\x05order\x18\x01 \x01(\v2\x13.cart.CheckoutOrderR\x05order2\xe6\x04\n" +
+	"\vCartService\x12A\n" +
+	"\n" +
+	"CreateCart\x12\x17.cart.CreateCartRequest\x1a\x18.cart.CreateCartResponse\"\x00\x128\n" +
+	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x15.cart.GetCartResponse\"\x00\x12L\n" +
+	"\x11GetCartForAccount\x12\x1e.cart.GetCartForAccountRequest\x1a\x15.cart.GetCartResponse\"\x00\x12=\n" +
+	"\vAddCartItem\x12\x18.cart.AddCartItemRequest\x1a\x12.cart.CartResponse\"\x00\x12C\n" +
+	"\x0eUpdateCartItem\x12\x1b.cart.UpdateCartItemRequest\x1a\x12.cart.CartResponse\"\x00\x12C\n" +
+	"\x0eRemoveCartItem\x12\x1b.cart.RemoveCartItemRequest\x1a\x12.cart.CartResponse\"\x00\x12;\n" +
+	"\n" +
+	"MergeCarts\x12\x17.cart.MergeCartsRequest\x1a\x12.cart.CartResponse\"\x00\x12=\n" +
+	"\vRefreshCart\x12\x18.cart.RefreshCartRequest\x1a\x12.cart.CartResponse\"\x00\x12G\n" +
+	"\fCheckoutCart\x12\x19.cart.CheckoutCartRequest\x1a\x1a.cart.CheckoutCartResponse\"\x00B\x0fZ\rgateway/protob\x06proto3"
+
+var (
+	file_gateway_proto_cart_proto_rawDescOnce sync.Once
+	file_gateway_proto_cart_proto_rawDescData []byte
+)
+
+func file_gateway_proto_cart_proto_rawDescGZIP() []byte {
+	file_gateway_proto_cart_proto_rawDescOnce.Do(func() {
+		file_gateway_proto_cart_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gateway_proto_cart_proto_rawDesc), len(file_gateway_proto_cart_proto_rawDesc)))
+	})
+	return file_gateway_proto_cart_proto_rawDescData
+}
+
+var file_gateway_proto_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_gateway_proto_cart_proto_goTypes = []any{
+	(*Cart)(nil),                       // 0: cart.Cart
+	(*CheckoutOrder)(nil),              // 1: cart.CheckoutOrder
+	(*ShippingAddress)(nil),            // 2: cart.ShippingAddress
+	(*CreateCartRequest)(nil),          // 3: cart.CreateCartRequest
+	(*CreateCartResponse)(nil),         // 4: cart.CreateCartResponse
+	(*GetCartRequest)(nil),             // 5: cart.GetCartRequest
+	(*GetCartForAccountRequest)(nil),   // 6: cart.GetCartForAccountRequest
+	(*GetCartResponse)(nil),            // 7: cart.GetCartResponse
+	(*AddCartItemRequest)(nil),         // 8: cart.AddCartItemRequest
+	(*UpdateCartItemRequest)(nil),      // 9: cart.UpdateCartItemRequest
+	(*RemoveCartItemRequest)(nil),      // 10: cart.RemoveCartItemRequest
+	(*MergeCartsRequest)(nil),          // 11: cart.MergeCartsRequest
+	(*RefreshCartRequest)(nil),         // 12: cart.RefreshCartRequest
+	(*CartResponse)(nil),               // 13: cart.CartResponse
+	(*CheckoutCartRequest)(nil),        // 14: cart.CheckoutCartRequest
+	(*CheckoutCartResponse)(nil),       // 15: cart.CheckoutCartResponse
+	(*Cart_CartItem)(nil),              // 16: cart.Cart.CartItem
+	(*CheckoutOrder_OrderCatalog)(nil), // 17: cart.CheckoutOrder.OrderCatalog
+}
+var file_gateway_proto_cart_proto_depIdxs = []int32{
+	16, // 0: cart.Cart.items:type_name -> cart.Cart.CartItem
+	17, // 1: cart.CheckoutOrder.catalogs:type_name -> cart.CheckoutOrder.OrderCatalog
+	2,  // 2: cart.CheckoutOrder.shippingAddress:type_name -> cart.ShippingAddress
+	0,  // 3: cart.CreateCartResponse.cart:type_name -> cart.Cart
+	0,  // 4: cart.GetCartResponse.cart:type_name -> cart.Cart
+	0,  // 5: cart.CartResponse.cart:type_name -> cart.Cart
+	1,  // 6: cart.CheckoutCartResponse.order:type_name -> cart.CheckoutOrder
+	3,  // 7: cart.CartService.CreateCart:input_type -> cart.CreateCartRequest
+	5,  // 8: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	6,  // 9: cart.CartService.GetCartForAccount:input_type -> cart.GetCartForAccountRequest
+	8,  // 10: cart.CartService.AddCartItem:input_type -> cart.AddCartItemRequest
+	9,  // 11: cart.CartService.UpdateCartItem:input_type -> cart.UpdateCartItemRequest
+	10, // 12: cart.CartService.RemoveCartItem:input_type -> cart.RemoveCartItemRequest
+	11, // 13: cart.CartService.MergeCarts:input_type -> cart.MergeCartsRequest
+	12, // 14: cart.CartService.RefreshCart:input_type -> cart.RefreshCartRequest
+	14, // 15: cart.CartService.CheckoutCart:input_type -> cart.CheckoutCartRequest
+	4,  // 16: cart.CartService.CreateCart:output_type -> cart.CreateCartResponse
+	7,  // 17: cart.CartService.GetCart:output_type -> cart.GetCartResponse
+	7,  // 18: cart.CartService.GetCartForAccount:output_type -> cart.GetCartResponse
+	13, // 19: cart.CartService.AddCartItem:output_type -> cart.CartResponse
+	13, // 20: cart.CartService.UpdateCartItem:output_type -> cart.CartResponse
+	13, // 21: cart.CartService.RemoveCartItem:output_type -> cart.CartResponse
+	13, // 22: cart.CartService.MergeCarts:output_type -> cart.CartResponse
+	13, // 23: cart.CartService.RefreshCart:output_type -> cart.CartResponse
+	15, // 24: cart.CartService.CheckoutCart:output_type -> cart.CheckoutCartResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_gateway_proto_cart_proto_init() }
+func file_gateway_proto_cart_proto_init() {
+	if File_gateway_proto_cart_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_proto_cart_proto_rawDesc), len(file_gateway_proto_cart_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gateway_proto_cart_proto_goTypes,
+		DependencyIndexes: file_gateway_proto_cart_proto_depIdxs,
+		MessageInfos:      file_gateway_proto_cart_proto_msgTypes,
+	}.Build()
+	File_gateway_proto_cart_proto = out.File
+	file_gateway_proto_cart_proto_goTypes = nil
+	file_gateway_proto_cart_proto_depIdxs = nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/config"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/gateway/cartHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/migrations"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/app"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
+	"log/slog"
+	"net/http"
+	"os"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrate(os.Args[2:]))
+	}
+
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		slog.Error("config.load.failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	a := app.New("cart", cfg.Application.ShutdownTimeout)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "cart", cfg.Tracing.Exporter)
+	if err != nil {
+		a.Fatal("tracing.setup.failed", err)
+	}
+	a.Append(app.Hook{Name: "tracing", Stop: shutdownTracing})
+
+	creds, err := mtls.NewCredentials(mtls.Config{
+		CertFile:     cfg.TLS.CertFile,
+		KeyFile:      cfg.TLS.KeyFile,
+		CAFile:       cfg.TLS.CAFile,
+		AllowedPeers: cfg.TLS.AllowedPeers,
+	})
+	if err != nil {
+		a.Fatal("tls.load.failed", err)
+	}
+
+	db, err := postgres.Connect(context.Background(), cfg.Postgresql)
+	if err != nil {
+		a.Fatal("postgres.connect.failed", err)
+	}
+	a.Append(app.Closer("postgres", db.Close))
+
+	router, err := postgres.NewRouter(db, cfg.Postgresql)
+	if err != nil {
+		a.Fatal("postgres.replicas.failed", err)
+	}
+	a.Append(app.Closer("postgres.replicas", router.Close))
+
+	metrics.RegisterDB(db, cfg.Postgresql.Name)
+
+	if cfg.Application.MigrateOnStart {
+		// The migrator closes the owner's pool when it's closed.
+		owner, err := postgres.ConnectOwner(context.Background(), cfg.Postgresql)
+		if err != nil {
+			a.Fatal("postgres.owner.connect.failed", err)
+		}
+		migrator, err := migrations.NewMigrator(owner, cfg.Postgresql.Name)
+		if err != nil {
+			a.Fatal("migrations.init.failed", err)
+		}
+		a.Append(
+			app.Closer("migrations", migrator.Close),
+			app.Hook{Name: "migrations", Start: migrator.Up},
+		)
+	}
+
+	retry := grpcx.RetryPolicy{
+		MaxAttempts:    cfg.Resilience.RetryMaxAttempts,
+		InitialBackoff: cfg.Resilience.RetryInitialBackoff,
+		MaxBackoff:     cfg.Resilience.RetryMaxBackoff,
+	}
+	catalogBreaker := grpcx.NewBreaker("catalog", cfg.Resilience.BreakerFailures, cfg.Resilience.BreakerCooldown)
+	orderBreaker := grpcx.NewBreaker("order", cfg.Resilience.BreakerFailures, cfg.Resilience.BreakerCooldown)
+
+	catalogClient, err := catalogHandler.NewGRPCCatalogClient(cfg.Application.CatalogPort, creds, retry, catalogBreaker)
+	if err != nil {
+		a.Fatal("catalogClient.failed", err)
+	}
+	a.Append(app.Closer("catalogClient", catalogClient.Close))
+
+	orderClient, err := orderHandler.NewGRPCOrderClient(cfg.Application.OrderPort, creds, retry, orderBreaker)
+	if err != nil {
+		a.Fatal("orderClient.failed", err)
+	}
+	a.Append(app.Closer("orderClient", orderClient.Close))
+
+	catalogHealth, err := health.NewRemote(cfg.Application.CatalogPort, creds.DialOption())
+	if err != nil {
+		a.Fatal("catalogHealth.failed", err)
+	}
+	a.Append(app.Closer("catalogHealth", catalogHealth.Close))
+
+	orderHealth, err := health.NewRemote(cfg.Application.OrderPort, creds.DialOption())
+	if err != nil {
+		a.Fatal("orderHealth.failed", err)
+	}
+	a.Append(app.Closer("orderHealth", orderHealth.Close))
+
+	cartRepository := repository.NewCartRepository(router, router)
+	cartService := service.NewCartService(cartRepository)
+	checker := health.NewChecker(proto.CartService_ServiceDesc.ServiceName, map[string]health.Check{
+		"postgres": db.PingContext,
+	}, map[string]health.Check{
+		"catalog":         catalogHealth.Check,
+		"catalog_breaker": catalogBreaker.Check,
+		"order":           orderHealth.Check,
+		"order_breaker":   orderBreaker.Check,
+	})
+	cartGRPCServer := cartHandler.NewGRPCCartServer(cartService, catalogClient, orderClient, checker, cfg.Application.GRPCReflection, creds)
+	metricsServer := metrics.NewServer(cfg.Application.MetricsPort)
+
+	a.Append(
+		app.Hook{
+			Name: "postgres.replicas",
+			Run:  router.Run,
+		},
+		app.Hook{
+			Name: "health",
+			Run: func(ctx context.Context) error {
+				checker.Run(ctx)
+				return nil
+			},
+		},
+		app.Hook{
+			Name: "metrics.server",
+			Run: func(context.Context) error {
+				slog.Info("metrics.server.starting", slog.String("addr", cfg.Application.MetricsPort))
+				if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			},
+			Stop: metricsServer.Shutdown,
+		},
+		app.Hook{
+			Name: "grpc.server",
+			Run: func(context.Context) error {
+				slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.CartPort))
+				return cartGRPCServer.Serve(cfg.Application.CartPort)
+			},
+			Stop: func(context.Context) error {
+				return cartGRPCServer.Stop()
+			},
+		},
+	)
+
+	if err := a.Run(context.Background()); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cart;
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS cart (
+    id CHAR(27) PRIMARY KEY,
+    account_id CHAR(27) UNIQUE,
+    -- The key of the order placed from the cart's lines; see
+    -- CartRepository.StartCheckout.
+    checkout_key CHAR(27),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+)
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cart_item;
\ No newline at end of file
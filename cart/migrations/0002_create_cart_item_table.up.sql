@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS cart_item (
+    cart_id CHAR(27) REFERENCES cart (id) ON DELETE CASCADE,
+    catalog_id CHAR(27),
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    price NUMERIC(12, 2) NOT NULL,
+    quantity INT NOT NULL,
+    PRIMARY KEY (catalog_id, cart_id)
+)
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Rows from before tenants belong to the default tenant; see the account
+-- migration of the same name.
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['cart', 'cart_item'] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT %L', t, 'default');
+        EXECUTE format('ALTER TABLE %I ALTER COLUMN tenant_id SET DEFAULT NULLIF(current_setting(%L, true), %L)', t, 'app.tenant_id', '');
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = NULLIF(current_setting(%L, true), %L))', t, 'app.tenant_id', '');
+    END LOOP;
+END $$
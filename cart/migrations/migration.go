@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/migration"
+)
+
+// Dir is where the migrate create command writes new migrations, relative to
+// the repository root.
+const Dir = "cart/migrations"
+
+//go:embed *.sql
+var migrationsFS embed.FS
+
+func NewMigrator(db *sql.DB, dbName string) (*migration.Migrator, error) {
+	return migration.New(db, dbName, migrationsFS)
+}
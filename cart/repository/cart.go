@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+type CartRepository interface {
+	CreateCart(ctx context.Context, cart *domain.Cart) error
+	GetCartById(ctx context.Context, id string) (*domain.Cart, error)
+	GetCartByAccountId(ctx context.Context, accountId string) (*domain.Cart, error)
+	AssignAccount(ctx context.Context, cartId, accountId string) error
+	AddCartItem(ctx context.Context, cartId string, item *domain.CartItem) error
+	UpsertCartItem(ctx context.Context, cartId string, item *domain.CartItem) error
+	DeleteCartItem(ctx context.Context, cartId, catalogId string) error
+	StartCheckout(ctx context.Context, cartId, key string) (string, error)
+	ClearCart(ctx context.Context, cartId string) error
+	MergeCarts(ctx context.Context, sourceId, targetId string) error
+}
+
+type cartRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+func (c *cartRepository) CreateCart(ctx context.Context, cart *domain.Cart) error {
+	query := `INSERT INTO cart(id, account_id, created_at, updated_at) VALUES ($1, $2, $3, $4)`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	_, err := c.dbWrite.ExecContext(ctx, query, cart.Id, nullString(cart.AccountId), cart.CreatedAt, cart.UpdatedAt)
+	return err
+}
+
+func (c *cartRepository) GetCartById(ctx context.Context, id string) (*domain.Cart, error) {
+	query := `SELECT id, account_id, created_at, updated_at FROM cart WHERE id=$1`
+	return c.getCart(ctx, query, id)
+}
+
+func (c *cartRepository) GetCartByAccountId(ctx context.Context, accountId string) (*domain.Cart, error) {
+	query := `SELECT id, account_id, created_at, updated_at FROM cart WHERE account_id=$1`
+	return c.getCart(ctx, query, accountId)
+}
+
+func (c *cartRepository) AssignAccount(ctx context.Context, cartId, accountId string) error {
+	query := `UPDATE cart SET account_id=$2, updated_at=$3 WHERE id=$1`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := c.dbWrite.ExecContext(ctx, query, cartId, accountId, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// AddCartItem adds a line to the cart, or adds its quantity to the line of
+// the same catalog, snapshotting the given name, description and price.
+func (c *cartRepository) AddCartItem(ctx context.Context, cartId string, item *domain.CartItem) error {
+	return c.putCartItem(ctx, cartId, item, `quantity=cart_item.quantity + EXCLUDED.quantity`)
+}
+
+// UpsertCartItem sets the line of the item's catalog to the item.
+func (c *cartRepository) UpsertCartItem(ctx context.Context, cartId string, item *domain.CartItem) error {
+	return c.putCartItem(ctx, cartId, item, `quantity=EXCLUDED.quantity`)
+}
+
+func (c *cartRepository) putCartItem(ctx context.Context, cartId string, item *domain.CartItem, setQuantity string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := c.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO cart_item(cart_id, catalog_id, name, description, price, quantity) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (catalog_id, cart_id) DO UPDATE SET name=EXCLUDED.name, description=EXCLUDED.description, price=EXCLUDED.price, `+setQuantity,
+		cartId,
+		item.CatalogId,
+		item.Name,
+		item.Description,
+		item.Price,
+		item.Quantity,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = touchCart(ctx, tx, cartId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *cartRepository) DeleteCartItem(ctx context.Context, cartId, catalogId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := c.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM cart_item WHERE cart_id=$1 AND catalog_id=$2`, cartId, catalogId)
+	if err != nil {
+		return err
+	}
+	if err = checkAffected(res); err != nil {
+		return err
+	}
+
+	if err = touchCart(ctx, tx, cartId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// StartCheckout gives the cart key as its checkout key, unless it has one
+// already, and returns the key the cart has. The key lasts until ClearCart or
+// a change to the lines, so every attempt to check out the same lines uses
+// the same key.
+func (c *cartRepository) StartCheckout(ctx context.Context, cartId, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := c.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	err = tx.QueryRowContext(ctx, `UPDATE cart SET checkout_key=COALESCE(checkout_key, $2) WHERE id=$1 RETURNING checkout_key`, cartId, key).Scan(&key)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = ErrNoRows
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return key, tx.Commit()
+}
+
+func (c *cartRepository) ClearCart(ctx context.Context, cartId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := c.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM cart_item WHERE cart_id=$1`, cartId); err != nil {
+		return err
+	}
+
+	// The lines are gone, and with them the checkout that ordered them.
+	res, err := tx.ExecContext(ctx, `UPDATE cart SET updated_at=$2, checkout_key=NULL WHERE id=$1`, cartId, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if err = checkAffected(res); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MergeCarts moves every line of the source cart into the target cart, summing
+// quantities of lines present in both, and deletes the source cart.
+func (c *cartRepository) MergeCarts(ctx context.Context, sourceId, targetId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := c.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+INSERT INTO cart_item(cart_id, catalog_id, name, description, price, quantity)
+SELECT $2, catalog_id, name, description, price, quantity FROM cart_item WHERE cart_id = $1
+ON CONFLICT (catalog_id, cart_id) DO UPDATE SET quantity = cart_item.quantity + EXCLUDED.quantity
+`, sourceId, targetId)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM cart WHERE id=$1`, sourceId)
+	if err != nil {
+		return err
+	}
+	if err = checkAffected(res); err != nil {
+		return err
+	}
+
+	if err = touchCart(ctx, tx, targetId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *cartRepository) getCart(ctx context.Context, query string, arg string) (*domain.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var cart domain.Cart
+	var accountId sql.NullString
+	if err := c.dbRead.QueryRowContext(ctx, query, arg).Scan(&cart.Id, &accountId, &cart.CreatedAt, &cart.UpdatedAt); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoRows
+		default:
+			return nil, err
+		}
+	}
+	cart.AccountId = accountId.String
+
+	rows, err := c.dbRead.QueryContext(ctx, `
+SELECT catalog_id, name, description, price::float8, quantity
+FROM cart_item
+WHERE cart_id = $1
+ORDER BY catalog_id
+`, cart.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cart.Items = make([]*domain.CartItem, 0)
+	for rows.Next() {
+		var item domain.CartItem
+		if err := rows.Scan(&item.CatalogId, &item.Name, &item.Description, &item.Price, &item.Quantity); err != nil {
+			return nil, err
+		}
+		cart.Items = append(cart.Items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+// touchCart records a change to the cart's lines. It drops the checkout key
+// too: a checkout started before the change ordered other lines, and one
+// whose ClearCart failed must not answer for the edited cart.
+func touchCart(ctx context.Context, tx *sql.Tx, cartId string) error {
+	res, err := tx.ExecContext(ctx, `UPDATE cart SET updated_at=$2, checkout_key=NULL WHERE id=$1`, cartId, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoRows
+	}
+	return nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func NewCartRepository(dbWrite postgres.Writer, dbRead postgres.Reader) CartRepository {
+	return &cartRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
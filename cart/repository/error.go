@@ -0,0 +1,7 @@
+package repository
+
+import "errors"
+
+var (
+	ErrNoRows = errors.New("record not found")
+)
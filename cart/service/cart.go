@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/repository"
+	"github.com/segmentio/ksuid"
+	"time"
+)
+
+var (
+	ErrInvalidQuantity = errors.New("invalid input: quantity must be greater than zero")
+	ErrNotGuestCart    = errors.New("cart already belongs to an account")
+	ErrEmptyCart       = errors.New("cart is empty")
+	ErrGuestCheckout   = errors.New("guest carts must be merged into an account cart before checkout")
+)
+
+type CartService interface {
+	CreateCart(ctx context.Context, accountId string) (*domain.Cart, error)
+	GetCartById(ctx context.Context, id string) (*domain.Cart, error)
+	GetCartForAccount(ctx context.Context, accountId string) (*domain.Cart, error)
+	AddItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error)
+	UpdateItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error)
+	RemoveItem(ctx context.Context, cartId, catalogId string) (*domain.Cart, error)
+	MergeCarts(ctx context.Context, input *dto.MergeCarts) (*domain.Cart, error)
+	RefreshCart(ctx context.Context, cartId string, items []*dto.CartItem) (*domain.Cart, error)
+	StartCheckout(ctx context.Context, cartId string) (string, error)
+	ClearCart(ctx context.Context, cartId string) error
+}
+
+type cartService struct {
+	cartRepository repository.CartRepository
+}
+
+// CreateCart returns a new guest cart when accountId is empty, otherwise the
+// account's existing cart, creating it on first use.
+func (c *cartService) CreateCart(ctx context.Context, accountId string) (*domain.Cart, error) {
+	if accountId != "" {
+		cart, err := c.GetCartForAccount(ctx, accountId)
+		if err == nil {
+			return cart, nil
+		}
+		if !errors.Is(err, repository.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	cart := &domain.Cart{
+		Id:        ksuid.New().String(),
+		AccountId: accountId,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Items:     []*domain.CartItem{},
+	}
+	if err := c.cartRepository.CreateCart(ctx, cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+func (c *cartService) GetCartById(ctx context.Context, id string) (*domain.Cart, error) {
+	cart, err := c.cartRepository.GetCartById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return withTotal(cart), nil
+}
+
+func (c *cartService) GetCartForAccount(ctx context.Context, accountId string) (*domain.Cart, error) {
+	cart, err := c.cartRepository.GetCartByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	return withTotal(cart), nil
+}
+
+func (c *cartService) AddItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error) {
+	if input.Quantity == 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	cart, err := c.cartRepository.GetCartById(ctx, input.CartId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cartRepository.AddCartItem(ctx, cart.Id, toItem(input, input.Quantity)); err != nil {
+		return nil, err
+	}
+	return c.GetCartById(ctx, cart.Id)
+}
+
+// UpdateItem sets the quantity of a cart line; a zero quantity removes it.
+func (c *cartService) UpdateItem(ctx context.Context, input *dto.CartItem) (*domain.Cart, error) {
+	if input.Quantity == 0 {
+		return c.RemoveItem(ctx, input.CartId, input.CatalogId)
+	}
+	if err := c.cartRepository.UpsertCartItem(ctx, input.CartId, toItem(input, input.Quantity)); err != nil {
+		return nil, err
+	}
+	return c.GetCartById(ctx, input.CartId)
+}
+
+func (c *cartService) RemoveItem(ctx context.Context, cartId, catalogId string) (*domain.Cart, error) {
+	if err := c.cartRepository.DeleteCartItem(ctx, cartId, catalogId); err != nil {
+		return nil, err
+	}
+	return c.GetCartById(ctx, cartId)
+}
+
+// MergeCarts folds a guest cart into the account's cart on login. When the
+// account has no cart yet the guest cart is simply assigned to it.
+func (c *cartService) MergeCarts(ctx context.Context, input *dto.MergeCarts) (*domain.Cart, error) {
+	guest, err := c.cartRepository.GetCartById(ctx, input.GuestCartId)
+	if err != nil {
+		return nil, err
+	}
+	if guest.AccountId != "" {
+		if guest.AccountId == input.AccountId {
+			return withTotal(guest), nil
+		}
+		return nil, ErrNotGuestCart
+	}
+
+	target, err := c.cartRepository.GetCartByAccountId(ctx, input.AccountId)
+	switch {
+	case errors.Is(err, repository.ErrNoRows):
+		if err := c.cartRepository.AssignAccount(ctx, guest.Id, input.AccountId); err != nil {
+			return nil, err
+		}
+		return c.GetCartById(ctx, guest.Id)
+	case err != nil:
+		return nil, err
+	}
+
+	if err := c.cartRepository.MergeCarts(ctx, guest.Id, target.Id); err != nil {
+		return nil, err
+	}
+	return c.GetCartById(ctx, target.Id)
+}
+
+// RefreshCart re-snapshots name, description and price of every cart line from
+// the given current catalog data. Lines whose catalog is no longer available
+// are dropped.
+func (c *cartService) RefreshCart(ctx context.Context, cartId string, items []*dto.CartItem) (*domain.Cart, error) {
+	cart, err := c.cartRepository.GetCartById(ctx, cartId)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]*dto.CartItem, len(items))
+	for _, item := range items {
+		current[item.CatalogId] = item
+	}
+
+	for _, item := range cart.Items {
+		fresh, ok := current[item.CatalogId]
+		if !ok {
+			if err := c.cartRepository.DeleteCartItem(ctx, cart.Id, item.CatalogId); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if fresh.Name == item.Name && fresh.Description == item.Description && fresh.Price == item.Price {
+			continue
+		}
+		if err := c.cartRepository.UpsertCartItem(ctx, cart.Id, toItem(fresh, item.Quantity)); err != nil {
+			return nil, err
+		}
+	}
+	return c.GetCartById(ctx, cart.Id)
+}
+
+// StartCheckout returns the key that orders the cart's lines; see
+// CartRepository.StartCheckout.
+func (c *cartService) StartCheckout(ctx context.Context, cartId string) (string, error) {
+	return c.cartRepository.StartCheckout(ctx, cartId, ksuid.New().String())
+}
+
+func (c *cartService) ClearCart(ctx context.Context, cartId string) error {
+	return c.cartRepository.ClearCart(ctx, cartId)
+}
+
+func toItem(input *dto.CartItem, quantity uint32) *domain.CartItem {
+	return &domain.CartItem{
+		CatalogId:   input.CatalogId,
+		Name:        input.Name,
+		Description: input.Description,
+		Price:       input.Price,
+		Quantity:    quantity,
+	}
+}
+
+func withTotal(cart *domain.Cart) *domain.Cart {
+	cart.TotalPrice = 0.0
+	for _, item := range cart.Items {
+		cart.TotalPrice += item.Price * float64(item.Quantity)
+	}
+	return cart
+}
+
+func NewCartService(cartRepository repository.CartRepository) CartService {
+	return &cartService{
+		cartRepository: cartRepository,
+	}
+}
@@ -1,5 +1,24 @@
 package config
 
+import (
+	"errors"
+	"time"
+)
+
 type Application struct {
-	CatalogPort string `env:"CATALOG_PORT"`
+	CatalogPort     string        `env:"CATALOG_PORT"`
+	GRPCReflection  bool          `env:"GRPC_REFLECTION"`
+	MetricsPort     string        `env:"METRICS_PORT" envDefault:":9102"`
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"30s"`
+}
+
+func (a *Application) Validate() error {
+	var errs []error
+	if a.CatalogPort == "" {
+		errs = append(errs, errors.New("CATALOG_PORT is required"))
+	}
+	if a.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT must be positive"))
+	}
+	return errors.Join(errs...)
 }
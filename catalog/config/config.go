@@ -1,7 +1,7 @@
 package config
 
 import (
-	"github.com/caarlos0/env/v11"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/configx"
 	"sync"
 )
 
@@ -14,12 +14,14 @@ var (
 type Config struct {
 	Application   Application
 	ElasticSearch ElasticSearch
+	Tracing       Tracing
+	TLS           TLS
 }
 
 func NewConfig() (*Config, error) {
 	once.Do(func() {
 		instance = &Config{}
-		initErr = env.Parse(instance)
+		initErr = configx.Load(instance)
 		if initErr != nil {
 			instance = nil
 		}
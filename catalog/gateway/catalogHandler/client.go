@@ -5,8 +5,11 @@ import (
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type GRPCCatalogClient interface {
@@ -72,8 +75,19 @@ func (g *gRPCCatalogClient) Close() error {
 	return g.conn.Close()
 }
 
-func NewGRPCCatalogClient(addr string) (GRPCCatalogClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+var catalogReads = []string{"GetCatalogById", "GetCatalogs"}
+
+func NewGRPCCatalogClient(addr string, creds *mtls.Credentials, retry grpcx.RetryPolicy, breaker *grpcx.Breaker, dialOpts ...grpc.DialOption) (GRPCCatalogClient, error) {
+	opts := []grpc.DialOption{
+		creds.DialOption(),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor()),
+	}
+	opts = append(opts, grpcx.DialOptions()...)
+	opts = append(opts, grpcx.ResilienceOptions(proto.CatalogService_ServiceDesc.ServiceName, catalogReads, retry, breaker)...)
+	opts = append(opts, dialOpts...)
+	conn, err := grpc.NewClient(addr, opts...)
 	if err != nil {
 		return nil, err
 	}
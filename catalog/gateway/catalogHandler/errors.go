@@ -0,0 +1,15 @@
+package catalogHandler
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"google.golang.org/grpc/codes"
+)
+
+var errorMapper = grpcx.NewErrorMapper("catalog",
+	grpcx.ErrorRule{Err: repository.ErrNotFound, Code: codes.NotFound, Reason: "CATALOG_NOT_FOUND"},
+	grpcx.ErrorRule{Err: service.ErrInvalidInput, Code: codes.InvalidArgument, Reason: "INVALID_CATALOG"},
+	grpcx.ErrorRule{Err: service.ErrTooManyIds, Code: codes.InvalidArgument, Reason: "TOO_MANY_IDS", Field: "ids"},
+	grpcx.ErrorRule{Err: service.ErrEmptyQuery, Code: codes.InvalidArgument, Reason: "EMPTY_QUERY", Field: "query"},
+)
@@ -6,7 +6,13 @@ import (
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/proto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"net"
 )
 
@@ -15,11 +21,15 @@ type GRPCCatalogServer interface {
 	GetCatalogById(ctx context.Context, req *proto.GetCatalogRequest) (*proto.GetCatalogResponse, error)
 	GetCatalogs(ctx context.Context, req *proto.GetCatalogsRequest) (*proto.GetCatalogsResponse, error)
 	Serve(addr string) error
+	ServeListener(lis net.Listener) error
 	Stop() error
 }
 
 type gRPCCatalogServer struct {
 	catalogService service.CatalogService
+	checker        *health.Checker
+	reflection     bool
+	creds          *mtls.Credentials
 	server         *grpc.Server
 	proto.UnimplementedCatalogServiceServer
 }
@@ -99,20 +109,41 @@ func (g *gRPCCatalogServer) Serve(addr string) error {
 	if err != nil {
 		return err
 	}
-	g.server = grpc.NewServer()
+	return g.ServeListener(lis)
+}
+
+// ServeListener serves on lis until the server stops, closing it then.
+func (g *gRPCCatalogServer) ServeListener(lis net.Listener) error {
+	opts := g.creds.ServerOptions()
+	opts = append(opts,
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor()),
+	)
+	opts = append(opts, grpcx.ServerOptions(errorMapper)...)
+	g.server = grpc.NewServer(opts...)
 	proto.RegisterCatalogServiceServer(g.server, g)
+	g.checker.Register(g.server)
+	metrics.InitializeServer(g.server)
+	if g.reflection {
+		reflection.Register(g.server)
+	}
 	return g.server.Serve(lis)
 }
 
 func (g *gRPCCatalogServer) Stop() error {
+	g.checker.Shutdown()
 	if g.server != nil {
 		g.server.GracefulStop()
 	}
 	return nil
 }
 
-func NewGRPCCatalogServer(catalogService service.CatalogService) GRPCCatalogServer {
+func NewGRPCCatalogServer(catalogService service.CatalogService, checker *health.Checker, reflection bool, creds *mtls.Credentials) GRPCCatalogServer {
 	return &gRPCCatalogServer{
 		catalogService: catalogService,
+		checker:        checker,
+		reflection:     reflection,
+		creds:          creds,
 	}
 }
@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -397,7 +398,7 @@ var File_gateway_proto_catalog_proto protoreflect.FileDescriptor
 
 const file_gateway_proto_catalog_proto_rawDesc = "" +
 	"\n" +
-	"\x1bgateway/proto/catalog.proto\x12\acatalog\"e\n" +
+	"\x1bgateway/proto/catalog.proto\x12\acatalog\x1a\x1cgoogle/api/annotations.proto\"e\n" +
 	"\aCatalog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -419,11 +420,11 @@ const file_gateway_proto_catalog_proto_rawDesc = "" +
 	"\x03ids\x18\x03 \x03(\tR\x03ids\x12\x14\n" +
 	"\x05query\x18\x04 \x01(\tR\x05query\"C\n" +
 	"\x13GetCatalogsResponse\x12,\n" +
-	"\bcatalogs\x18\x01 \x03(\v2\x10.catalog.CatalogR\bcatalogs2\xfb\x01\n" +
-	"\x0eCatalogService\x12P\n" +
-	"\rCreateCatalog\x12\x1d.catalog.CreateCatalogRequest\x1a\x1e.catalog.CreateCatalogResponse\"\x00\x12K\n" +
-	"\x0eGetCatalogById\x12\x1a.catalog.GetCatalogRequest\x1a\x1b.catalog.GetCatalogResponse\"\x00\x12J\n" +
-	"\vGetCatalogs\x12\x1b.catalog.GetCatalogsRequest\x1a\x1c.catalog.GetCatalogsResponse\"\x00B\x0fZ\rgateway/protob\x06proto3"
+	"\bcatalogs\x18\x01 \x03(\v2\x10.catalog.CatalogR\bcatalogs2\xbf\x02\n" +
+	"\x0eCatalogService\x12g\n" +
+	"\rCreateCatalog\x12\x1d.catalog.CreateCatalogRequest\x1a\x1e.catalog.CreateCatalogResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/products\x12d\n" +
+	"\x0eGetCatalogById\x12\x1a.catalog.GetCatalogRequest\x1a\x1b.catalog.GetCatalogResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/products/{id}\x12^\n" +
+	"\vGetCatalogs\x12\x1b.catalog.GetCatalogsRequest\x1a\x1c.catalog.GetCatalogsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/productsB\x0fZ\rgateway/protob\x06proto3"
 
 var (
 	file_gateway_proto_catalog_proto_rawDescOnce sync.Once
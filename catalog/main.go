@@ -2,16 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/config"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/proto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/repository"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/service"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/utils"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/app"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"log/slog"
+	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
 )
 
 func main() {
@@ -23,6 +28,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	a := app.New("catalog", cfg.Application.ShutdownTimeout)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "catalog", cfg.Tracing.Exporter)
+	if err != nil {
+		a.Fatal("tracing.setup.failed", err)
+	}
+	a.Append(app.Hook{Name: "tracing", Stop: shutdownTracing})
+
+	creds, err := mtls.NewCredentials(mtls.Config{
+		CertFile:     cfg.TLS.CertFile,
+		KeyFile:      cfg.TLS.KeyFile,
+		CAFile:       cfg.TLS.CAFile,
+		AllowedPeers: cfg.TLS.AllowedPeers,
+	})
+	if err != nil {
+		a.Fatal("tls.load.failed", err)
+	}
+
 	elastic := utils.NewElasticSearch(
 		utils.WithHost(cfg.ElasticSearch.Host),
 		utils.WithPort(cfg.ElasticSearch.Port),
@@ -33,45 +56,49 @@ func main() {
 
 	client, err := elastic.Connect()
 	if err != nil {
-		slog.Error("elastic.connect.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("elastic.connect.failed", err)
 	}
 
 	catalogRepository := repository.NewCatalogRepository(client, "catalogs")
 	catalogService := service.NewCatalogService(catalogRepository)
-	catalogGRPCServer := catalogHandler.NewGRPCCatalogServer(catalogService)
-
-	serverErrCh := make(chan error, 1)
-	go func() {
-		slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.CatalogPort))
-		serverErrCh <- catalogGRPCServer.Serve(cfg.Application.CatalogPort)
-	}()
+	checker := health.NewChecker(proto.CatalogService_ServiceDesc.ServiceName, map[string]health.Check{
+		"elasticsearch": utils.ClusterHealth(client),
+	}, nil)
+	catalogGRPCServer := catalogHandler.NewGRPCCatalogServer(catalogService, checker, cfg.Application.GRPCReflection, creds)
+	metricsServer := metrics.NewServer(cfg.Application.MetricsPort)
 
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-	select {
-	case sig := <-sigCh:
-		slog.Info("shutdown.signal.received", slog.String("signal", sig.String()))
-	case err := <-serverErrCh:
-		slog.Error("grpc.server.failed", slog.String("error", err.Error()))
-	}
-
-	slog.Info("shutdown.initiating", slog.String("timeout", "30s"))
-
-	if stopErr := catalogGRPCServer.Stop(); stopErr != nil {
-		slog.Error("grpc.stop.failed", slog.String("error", stopErr.Error()))
-	}
+	a.Append(
+		app.Hook{
+			Name: "health",
+			Run: func(ctx context.Context) error {
+				checker.Run(ctx)
+				return nil
+			},
+		},
+		app.Hook{
+			Name: "metrics.server",
+			Run: func(context.Context) error {
+				slog.Info("metrics.server.starting", slog.String("addr", cfg.Application.MetricsPort))
+				if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			},
+			Stop: metricsServer.Shutdown,
+		},
+		app.Hook{
+			Name: "grpc.server",
+			Run: func(context.Context) error {
+				slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.CatalogPort))
+				return catalogGRPCServer.Serve(cfg.Application.CatalogPort)
+			},
+			Stop: func(context.Context) error {
+				return catalogGRPCServer.Stop()
+			},
+		},
+	)
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer shutdownCancel()
-	select {
-	case <-shutdownCtx.Done():
-		slog.Warn("shutdown.timeout.reached")
-	case <-time.After(5 * time.Second):
-		slog.Info("shutdown.drain.complete")
+	if err := a.Run(context.Background()); err != nil {
+		os.Exit(1)
 	}
-
-	close(sigCh)
-	slog.Info("shutdown.complete")
-
 }
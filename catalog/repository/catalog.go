@@ -9,9 +9,15 @@ import (
 	"github.com/elastic/go-elasticsearch/v8/esapi"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
 	"net/http"
 )
 
+// tenantField is what queries filter tenants on. The index is mapped
+// dynamically, which makes tenant_id a text field with an exact keyword
+// sub-field.
+const tenantField = "tenant_id.keyword"
+
 type CatalogRepository interface {
 	CreateCatalog(ctx context.Context, catalog *domain.Catalog) error
 	GetCatalogById(ctx context.Context, id string) (*domain.Catalog, error)
@@ -20,24 +26,42 @@ type CatalogRepository interface {
 	SearchCatalog(ctx context.Context, input *dto.SearchCatalog) ([]*domain.Catalog, error)
 }
 
+// catalogRepository keeps the catalogs of every tenant in one index. Each
+// document carries its tenant, searches filter on it and lookups by id drop
+// documents of other tenants. Documents indexed before tenants existed have
+// none and must be given one, e.g. with an update by query that sets
+// tenant_id to "default", before they show up again.
 type catalogRepository struct {
 	client *elasticsearch.Client
 	index  string
 }
 
+// document is a catalog as indexed.
+type document struct {
+	domain.Catalog
+	TenantId string `json:"tenant_id"`
+}
+
 func (c *catalogRepository) CreateCatalog(ctx context.Context, catalog *domain.Catalog) error {
-	data, err := json.Marshal(catalog)
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return tenant.ErrMissing
+	}
+	data, err := json.Marshal(document{Catalog: *catalog, TenantId: tenantId})
 	if err != nil {
 		return fmt.Errorf("failed to marshal catalog: %w", err)
 	}
 
+	// Creating rather than indexing never replaces a document, which may
+	// belong to another tenant.
 	req := esapi.IndexRequest{
 		Index:      c.index,
 		DocumentID: catalog.Id,
+		OpType:     "create",
 		Body:       bytes.NewReader(data),
 		Refresh:    "true", // Immediate visibility
 	}
-	res, err := req.Do(ctx, c.client)
+	res, err := c.do(ctx, "index", req)
 	if err != nil {
 		return fmt.Errorf("failed to index catalog: %w", err)
 	}
@@ -50,11 +74,15 @@ func (c *catalogRepository) CreateCatalog(ctx context.Context, catalog *domain.C
 }
 
 func (c *catalogRepository) GetCatalogById(ctx context.Context, id string) (*domain.Catalog, error) {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return nil, tenant.ErrMissing
+	}
 	req := esapi.GetRequest{
 		Index:      c.index,
 		DocumentID: id,
 	}
-	res, err := req.Do(ctx, c.client)
+	res, err := c.do(ctx, "get", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get catalog: %w", err)
 	}
@@ -67,37 +95,31 @@ func (c *catalogRepository) GetCatalogById(ctx context.Context, id string) (*dom
 		return nil, fmt.Errorf("elasticsearch get failed with status %d", res.StatusCode)
 	}
 
-	var catalog domain.Catalog
-	if err := json.NewDecoder(res.Body).Decode(&catalog); err != nil {
+	var result struct {
+		Source document `json:"_source"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode catalog: %w", err)
 	}
-	return &catalog, nil
+	if result.Source.TenantId != tenantId {
+		return nil, ErrNotFound
+	}
+	return &result.Source.Catalog, nil
 }
 
 func (c *catalogRepository) GetCatalogs(ctx context.Context, input *dto.CatalogQuery) ([]*domain.Catalog, error) {
 	// Build dynamic search body based on input (query or ids or all)
-	body, err := c.buildSearchBody(input)
+	body, err := c.buildSearchBody(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
-	var searchReq *esapi.SearchRequest
-	if body != nil {
-		searchReq = &esapi.SearchRequest{
-			Index: []string{c.index},
-			Body:  body,
-		}
-	} else {
-		from := int(input.Offset) // Temporary int for *int
-		size := int(input.Limit)  // Temporary int for *int
-		searchReq = &esapi.SearchRequest{
-			Index: []string{c.index},
-			From:  &from,
-			Size:  &size,
-		}
+	searchReq := &esapi.SearchRequest{
+		Index: []string{c.index},
+		Body:  body,
 	}
 
-	res, err := searchReq.Do(ctx, c.client)
+	res, err := c.do(ctx, "list", searchReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get catalogs: %w", err)
 	}
@@ -110,7 +132,7 @@ func (c *catalogRepository) GetCatalogs(ctx context.Context, input *dto.CatalogQ
 	var result struct {
 		Hits struct {
 			Hits []struct {
-				Source domain.Catalog `json:"_source"`
+				Source document `json:"_source"`
 			} `json:"hits"`
 		} `json:"hits"`
 	}
@@ -120,19 +142,23 @@ func (c *catalogRepository) GetCatalogs(ctx context.Context, input *dto.CatalogQ
 
 	catalogs := make([]*domain.Catalog, 0, len(result.Hits.Hits))
 	for _, hit := range result.Hits.Hits {
-		catalogs = append(catalogs, &hit.Source)
+		catalogs = append(catalogs, &hit.Source.Catalog)
 	}
 	return catalogs, nil
 }
 
 func (c *catalogRepository) GetCatalogsByIds(ctx context.Context, ids []string) ([]*domain.Catalog, error) {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return nil, tenant.ErrMissing
+	}
 	var mgetReq esapi.MgetRequest
 	mgetReq = esapi.MgetRequest{
 		Index: c.index,
 		Body:  c.buildMgetBody(ids),
 	}
 
-	res, err := mgetReq.Do(ctx, c.client)
+	res, err := c.do(ctx, "mget", mgetReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to multi-get catalogs: %w", err)
 	}
@@ -144,8 +170,8 @@ func (c *catalogRepository) GetCatalogsByIds(ctx context.Context, ids []string)
 
 	var result struct {
 		Docs []struct {
-			Found  bool           `json:"found"`
-			Source domain.Catalog `json:"_source"`
+			Found  bool     `json:"found"`
+			Source document `json:"_source"`
 		} `json:"docs"`
 	}
 	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
@@ -154,15 +180,15 @@ func (c *catalogRepository) GetCatalogsByIds(ctx context.Context, ids []string)
 
 	catalogs := make([]*domain.Catalog, 0)
 	for _, doc := range result.Docs {
-		if doc.Found {
-			catalogs = append(catalogs, &doc.Source)
+		if doc.Found && doc.Source.TenantId == tenantId {
+			catalogs = append(catalogs, &doc.Source.Catalog)
 		}
 	}
 	return catalogs, nil
 }
 
 func (c *catalogRepository) SearchCatalog(ctx context.Context, input *dto.SearchCatalog) ([]*domain.Catalog, error) {
-	body, err := c.buildSearchBody(&dto.CatalogQuery{Query: input.Query, Limit: input.Limit, Offset: input.Offset})
+	body, err := c.buildSearchBody(ctx, &dto.CatalogQuery{Query: input.Query, Limit: input.Limit, Offset: input.Offset})
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +198,7 @@ func (c *catalogRepository) SearchCatalog(ctx context.Context, input *dto.Search
 		Body:  body,
 	}
 
-	res, err := searchReq.Do(ctx, c.client)
+	res, err := c.do(ctx, "search", searchReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search catalogs: %w", err)
 	}
@@ -185,7 +211,7 @@ func (c *catalogRepository) SearchCatalog(ctx context.Context, input *dto.Search
 	var result struct {
 		Hits struct {
 			Hits []struct {
-				Source domain.Catalog `json:"_source"`
+				Source document `json:"_source"`
 			} `json:"hits"`
 		} `json:"hits"`
 	}
@@ -195,47 +221,59 @@ func (c *catalogRepository) SearchCatalog(ctx context.Context, input *dto.Search
 
 	catalogs := make([]*domain.Catalog, 0, len(result.Hits.Hits))
 	for _, hit := range result.Hits.Hits {
-		catalogs = append(catalogs, &hit.Source)
+		catalogs = append(catalogs, &hit.Source.Catalog)
 	}
 	return catalogs, nil
 }
 
-func (c *catalogRepository) buildSearchBody(input *dto.CatalogQuery) (*bytes.Reader, error) {
-	if input.Query != "" {
-		queryBody := map[string]interface{}{
-			"query": map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query":  input.Query,
-					"fields": []string{"name", "description"},
-				},
+// buildSearchBody matches the query, else the ids, else every catalog of
+// input, among the catalogs of the tenant of ctx.
+func (c *catalogRepository) buildSearchBody(ctx context.Context, input *dto.CatalogQuery) (*bytes.Reader, error) {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return nil, tenant.ErrMissing
+	}
+
+	var query map[string]interface{}
+	switch {
+	case input.Query != "":
+		query = map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  input.Query,
+				"fields": []string{"name", "description"},
 			},
-			"from": input.Offset,
-			"size": input.Limit,
 		}
-		data, err := json.Marshal(queryBody)
-		if err != nil {
-			return nil, err
+	case len(input.Ids) > 0:
+		query = map[string]interface{}{
+			"terms": map[string]interface{}{
+				"id": input.Ids,
+			},
+		}
+	default:
+		query = map[string]interface{}{
+			"match_all": map[string]interface{}{},
 		}
-		return bytes.NewReader(data), nil
 	}
-	if len(input.Ids) > 0 {
-		idsBody := map[string]interface{}{
-			"query": map[string]interface{}{
-				"terms": map[string]interface{}{
-					"id": input.Ids,
+
+	body := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": query,
+				"filter": map[string]interface{}{
+					"term": map[string]interface{}{
+						tenantField: tenantId,
+					},
 				},
 			},
-			"from": input.Offset,
-			"size": input.Limit,
-		}
-		data, err := json.Marshal(idsBody)
-		if err != nil {
-			return nil, err
-		}
-		return bytes.NewReader(data), nil
+		},
+		"from": input.Offset,
+		"size": input.Limit,
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	// For paginate without query/ids, return nil (use From/Size in SearchRequest)
-	return nil, nil
+	return bytes.NewReader(data), nil
 }
 
 func (c *catalogRepository) buildMgetBody(ids []string) *bytes.Reader {
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// memoryCatalogRepository keeps catalogs in insertion order, for tests and
+// local runs without Elasticsearch. Text queries match any of their words
+// in the name or description, ignoring case, standing in for multi_match.
+// Like the index, it holds every tenant and only shows a context the
+// catalogs of its own.
+type memoryCatalogRepository struct {
+	mu       sync.RWMutex
+	catalogs []document
+	byId     map[string]int
+}
+
+func (m *memoryCatalogRepository) CreateCatalog(ctx context.Context, catalog *domain.Catalog) error {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return tenant.ErrMissing
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.byId[catalog.Id]; ok {
+		return fmt.Errorf("catalog %s already exists", catalog.Id)
+	}
+	m.byId[catalog.Id] = len(m.catalogs)
+	m.catalogs = append(m.catalogs, document{Catalog: *catalog, TenantId: tenantId})
+	return nil
+}
+
+func (m *memoryCatalogRepository) GetCatalogById(ctx context.Context, id string) (*domain.Catalog, error) {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return nil, tenant.ErrMissing
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	i, ok := m.byId[id]
+	if !ok || m.catalogs[i].TenantId != tenantId {
+		return nil, ErrNotFound
+	}
+	catalog := m.catalogs[i].Catalog
+	return &catalog, nil
+}
+
+func (m *memoryCatalogRepository) GetCatalogs(ctx context.Context, input *dto.CatalogQuery) ([]*domain.Catalog, error) {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return nil, tenant.ErrMissing
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var catalogs []*domain.Catalog
+	for _, doc := range m.catalogs {
+		if doc.TenantId != tenantId {
+			continue
+		}
+		switch {
+		case input.Query != "":
+			if !matches(&doc.Catalog, input.Query) {
+				continue
+			}
+		case len(input.Ids) > 0:
+			if !slices.Contains(input.Ids, doc.Id) {
+				continue
+			}
+		}
+		catalogs = append(catalogs, &doc.Catalog)
+	}
+	return page(catalogs, input.Offset, input.Limit), nil
+}
+
+func (m *memoryCatalogRepository) GetCatalogsByIds(ctx context.Context, ids []string) ([]*domain.Catalog, error) {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return nil, tenant.ErrMissing
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	catalogs := make([]*domain.Catalog, 0)
+	for _, id := range ids {
+		if i, ok := m.byId[id]; ok && m.catalogs[i].TenantId == tenantId {
+			catalog := m.catalogs[i].Catalog
+			catalogs = append(catalogs, &catalog)
+		}
+	}
+	return catalogs, nil
+}
+
+func (m *memoryCatalogRepository) SearchCatalog(ctx context.Context, input *dto.SearchCatalog) ([]*domain.Catalog, error) {
+	return m.GetCatalogs(ctx, &dto.CatalogQuery{Query: input.Query, Limit: input.Limit, Offset: input.Offset})
+}
+
+func matches(catalog *domain.Catalog, query string) bool {
+	text := strings.ToLower(catalog.Name + " " + catalog.Description)
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if strings.Contains(text, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func page(catalogs []*domain.Catalog, offset, limit uint64) []*domain.Catalog {
+	offset = min(offset, uint64(len(catalogs)))
+	end := min(offset+limit, uint64(len(catalogs)))
+	return catalogs[offset:end]
+}
+
+func NewMemoryCatalogRepository() CatalogRepository {
+	return &memoryCatalogRepository{
+		byId: make(map[string]int),
+	}
+}
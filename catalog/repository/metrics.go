@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"strconv"
+	"time"
+)
+
+var elasticsearchRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "elasticsearch_request_duration_seconds",
+	Help:    "Duration of Elasticsearch requests made by the catalog repository.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"operation", "status"})
+
+// do runs req and records how long it took, labelled with operation and the
+// response status, or "error" when no response came back.
+func (c *catalogRepository) do(ctx context.Context, operation string, req esapi.Request) (*esapi.Response, error) {
+	start := time.Now()
+	res, err := req.Do(ctx, c.client)
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(res.StatusCode)
+	}
+	elasticsearchRequestDuration.WithLabelValues(operation, status).Observe(time.Since(start).Seconds())
+	return res, err
+}
@@ -12,6 +12,8 @@ import (
 
 var (
 	ErrInvalidInput = errors.New("invalid input: name required, price > 0")
+	ErrTooManyIds   = errors.New("invalid input: too many IDs")
+	ErrEmptyQuery   = errors.New("invalid input: search query required")
 )
 
 type CatalogService interface {
@@ -65,14 +67,14 @@ func (c *catalogService) GetCatalogsByIds(ctx context.Context, ids []string) ([]
 		return []*domain.Catalog{}, nil
 	}
 	if len(ids) > 50 {
-		return nil, errors.New("too many IDs")
+		return nil, ErrTooManyIds
 	}
 	return c.catalogRepository.GetCatalogsByIds(ctx, ids)
 }
 
 func (c *catalogService) SearchCatalog(ctx context.Context, input *dto.SearchCatalog) ([]*domain.Catalog, error) {
 	if input.Query == "" {
-		return nil, errors.New("search query required")
+		return nil, ErrEmptyQuery
 	}
 	if input.Limit == 0 || input.Limit > 100 {
 		input.Limit = 10
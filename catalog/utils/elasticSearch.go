@@ -2,11 +2,13 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/elastic/go-elasticsearch/v8"
+	"go.opentelemetry.io/otel"
 )
 
 type ElasticSearch struct {
@@ -59,6 +61,8 @@ func (e *ElasticSearch) Connect() (*elasticsearch.Client, error) {
 		Username:   e.Username,
 		Password:   e.Password,
 		MaxRetries: 3,
+		// Search bodies may hold customer queries, so spans leave them out.
+		Instrumentation: elasticsearch.NewOpenTelemetryInstrumentation(otel.GetTracerProvider(), false),
 	}
 
 	client, err := elasticsearch.NewClient(cfg)
@@ -82,6 +86,32 @@ func (e *ElasticSearch) Connect() (*elasticsearch.Client, error) {
 	return client, nil
 }
 
+// ClusterHealth reports an error while the cluster can't be reached or its
+// status is red. A yellow cluster still serves reads and writes.
+func ClusterHealth(client *elasticsearch.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		res, err := client.Cluster.Health(client.Cluster.Health.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.IsError() {
+			return fmt.Errorf("elasticsearch cluster health failed with status: %s", res.Status())
+		}
+
+		var health struct {
+			Status string `json:"status"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&health); err != nil {
+			return err
+		}
+		if health.Status == "red" {
+			return fmt.Errorf("elasticsearch cluster status is %s", health.Status)
+		}
+		return nil
+	}
+}
+
 func NewElasticSearch(opts ...Option) *ElasticSearch {
 	es := &ElasticSearch{}
 	for _, opt := range opts {
@@ -0,0 +1,112 @@
+package e2e
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	cartDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	cartMigrations "github.com/saleh-ghazimoradi/MircoEcoMarket/cart/migrations"
+	cartRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/cart/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"github.com/segmentio/ksuid"
+)
+
+// storedCarts returns a cart repository on a database of the server named
+// by postgresURLEnv, skipping t when it is unset.
+func storedCarts(t *testing.T) cartRepository.CartRepository {
+	t.Helper()
+	postgresURL := os.Getenv(postgresURLEnv)
+	if postgresURL == "" {
+		t.Skipf("%s is unset", postgresURLEnv)
+	}
+	carts := database(t, postgresConfig(t, postgresURL), "cart", cartMigrations.NewMigrator)
+	return cartRepository.NewCartRepository(carts, carts)
+}
+
+func newCart() *cartDomain.Cart {
+	now := time.Now().UTC()
+	return &cartDomain.Cart{Id: ksuid.New().String(), CreatedAt: now, UpdatedAt: now}
+}
+
+// TestEditedCartGetsNewCheckoutKey edits a cart whose checkout placed an
+// order but failed to clear it; checking out again must not reuse the key
+// of that order.
+func TestEditedCartGetsNewCheckoutKey(t *testing.T) {
+	carts := storedCarts(t)
+	ctx := tenant.NewContext(t.Context(), Tenant)
+
+	for _, tt := range []struct {
+		name string
+		edit func(cartId string) error
+	}{
+		{"add", func(cartId string) error {
+			return carts.AddCartItem(ctx, cartId, &cartDomain.CartItem{CatalogId: "lamp", Name: "Lamp", Price: 30, Quantity: 1})
+		}},
+		{"update", func(cartId string) error {
+			return carts.UpsertCartItem(ctx, cartId, &cartDomain.CartItem{CatalogId: "desk", Name: "Desk", Price: 120, Quantity: 3})
+		}},
+		{"remove", func(cartId string) error {
+			return carts.DeleteCartItem(ctx, cartId, "desk")
+		}},
+		{"merge", func(cartId string) error {
+			guest := newCart()
+			if err := carts.CreateCart(ctx, guest); err != nil {
+				return err
+			}
+			if err := carts.AddCartItem(ctx, guest.Id, &cartDomain.CartItem{CatalogId: "lamp", Name: "Lamp", Price: 30, Quantity: 1}); err != nil {
+				return err
+			}
+			return carts.MergeCarts(ctx, guest.Id, cartId)
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := newCart()
+			if err := carts.CreateCart(ctx, cart); err != nil {
+				t.Fatal(err)
+			}
+			if err := carts.AddCartItem(ctx, cart.Id, &cartDomain.CartItem{CatalogId: "desk", Name: "Desk", Price: 120, Quantity: 1}); err != nil {
+				t.Fatal(err)
+			}
+			first, err := carts.StartCheckout(ctx, cart.Id, "first")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The order of first is placed and ClearCart fails, so the
+			// lines and the key stay.
+			if err := tt.edit(cart.Id); err != nil {
+				t.Fatal(err)
+			}
+			again, err := carts.StartCheckout(ctx, cart.Id, "again")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again == first {
+				t.Errorf("checkout of the edited cart reused key %q", first)
+			}
+		})
+	}
+}
+
+func TestAddCartItemAddsQuantities(t *testing.T) {
+	carts := storedCarts(t)
+	ctx := tenant.NewContext(t.Context(), Tenant)
+
+	cart := newCart()
+	if err := carts.CreateCart(ctx, cart); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if err := carts.AddCartItem(ctx, cart.Id, &cartDomain.CartItem{CatalogId: "desk", Name: "Desk", Price: 120, Quantity: 2}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := carts.GetCartById(ctx, cart.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Items) != 1 || got.Items[0].Quantity != 6 {
+		t.Errorf("cart lines = %+v, want one desk of quantity 6", got.Items)
+	}
+}
@@ -0,0 +1,332 @@
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type product struct {
+	name  string
+	price float64
+}
+
+type line struct {
+	product  int
+	quantity int
+}
+
+func createAccount(t *testing.T, h *Harness, name string) string {
+	t.Helper()
+	var resp struct {
+		CreateAccount struct{ ID string }
+	}
+	h.Client.MustPost(`mutation($name: String!) { createAccount(account: {name: $name}) { id } }`, &resp, client.Var("name", name))
+	return resp.CreateAccount.ID
+}
+
+func createProduct(t *testing.T, h *Harness, p product) string {
+	t.Helper()
+	var resp struct {
+		CreateProduct struct{ ID string }
+	}
+	h.Client.MustPost(`mutation($name: String!, $price: Float!) {
+  createProduct(product: {name: $name, description: "A product for testing", price: $price}) { id }
+}`, &resp, client.Var("name", p.name), client.Var("price", p.price))
+	return resp.CreateProduct.ID
+}
+
+type orderedProduct struct {
+	ID       string
+	Name     string
+	Price    float64
+	Quantity int
+}
+
+type order struct {
+	ID         string
+	AccountID  string
+	TotalPrice float64
+	Status     string
+	Products   []orderedProduct
+}
+
+const orderFields = `id accountId totalPrice status products { id name price quantity }`
+
+func createOrder(c *client.Client, accountID string, products []map[string]any) (order, error) {
+	var resp struct {
+		CreateOrder order
+	}
+	err := c.Post(`mutation($accountId: String!, $products: [OrderedProductInput!]!) {
+  createOrder(order: {accountId: $accountId, products: $products}) { `+orderFields+` }
+}`, &resp, client.Var("accountId", accountID), client.Var("products", products))
+	return resp.CreateOrder, err
+}
+
+func queryOrders(t *testing.T, c *client.Client, accountID string) []order {
+	t.Helper()
+	var resp struct {
+		Orders []order
+	}
+	c.MustPost(`query($accountId: String!) {
+  orders(order: {accountId: $accountId, products: []}) { `+orderFields+` }
+}`, &resp, client.Var("accountId", accountID))
+	return resp.Orders
+}
+
+func TestOrderFlow(t *testing.T) {
+	tests := []struct {
+		name      string
+		products  []product
+		orders    [][]line
+		wantTotal []float64
+	}{
+		{
+			name:      "single product",
+			products:  []product{{"Desk lamp", 25}},
+			orders:    [][]line{{{0, 1}}},
+			wantTotal: []float64{25},
+		},
+		{
+			name:      "quantities multiply prices",
+			products:  []product{{"Notebook", 3.5}, {"Pen", 1.25}},
+			orders:    [][]line{{{0, 2}, {1, 4}}},
+			wantTotal: []float64{12},
+		},
+		{
+			name:      "several orders, newest first",
+			products:  []product{{"Mug", 8}, {"Kettle", 40}, {"Tea", 6}},
+			orders:    [][]line{{{0, 1}}, {{1, 1}, {2, 3}}, {{2, 1}}},
+			wantTotal: []float64{6, 58, 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(t)
+			accountID := createAccount(t, h, "Ada")
+
+			productIDs := make([]string, len(tt.products))
+			for i, p := range tt.products {
+				productIDs[i] = createProduct(t, h, p)
+			}
+
+			created := make(map[string]order)
+			for _, lines := range tt.orders {
+				var input []map[string]any
+				for _, l := range lines {
+					input = append(input, map[string]any{"id": productIDs[l.product], "quantity": l.quantity})
+				}
+				o, err := createOrder(h.Client, accountID, input)
+				if err != nil {
+					t.Fatalf("createOrder: %v", err)
+				}
+				if o.Status != "pending" {
+					t.Errorf("created order status = %q, want pending", o.Status)
+				}
+				created[o.ID] = o
+			}
+
+			orders := queryOrders(t, h.Client, accountID)
+			if len(orders) != len(tt.wantTotal) {
+				t.Fatalf("got %d orders, want %d", len(orders), len(tt.wantTotal))
+			}
+			for i, o := range orders {
+				if _, ok := created[o.ID]; !ok {
+					t.Errorf("order %s was not created by this test", o.ID)
+				}
+				if o.AccountID != accountID {
+					t.Errorf("order %s belongs to %s, want %s", o.ID, o.AccountID, accountID)
+				}
+				if o.TotalPrice != tt.wantTotal[i] {
+					t.Errorf("order %d total = %v, want %v", i, o.TotalPrice, tt.wantTotal[i])
+				}
+				var sum float64
+				for _, p := range o.Products {
+					sum += p.Price * float64(p.Quantity)
+					if p.Name == "" {
+						t.Errorf("product %s of order %s has no name", p.ID, o.ID)
+					}
+				}
+				if sum != o.TotalPrice {
+					t.Errorf("order %s lines sum to %v, total is %v", o.ID, sum, o.TotalPrice)
+				}
+			}
+
+			stored, err := h.Orders.GetOrdersByIds(tenant.NewContext(t.Context(), Tenant), keys(created))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stored) != len(created) {
+				t.Errorf("repository holds %d orders, want %d", len(stored), len(created))
+			}
+		})
+	}
+}
+
+func TestOrderErrors(t *testing.T) {
+	h := New(t)
+	accountID := createAccount(t, h, "Grace")
+	productID := createProduct(t, h, product{"Chair", 90})
+
+	tests := []struct {
+		name      string
+		accountID string
+		products  []map[string]any
+		wantErr   string
+	}{
+		{
+			name:      "unknown account",
+			accountID: "missing",
+			products:  []map[string]any{{"id": productID, "quantity": 1}},
+			wantErr:   "NOT_FOUND",
+		},
+		{
+			name:      "no products",
+			accountID: accountID,
+			products:  []map[string]any{},
+			wantErr:   "at least one product",
+		},
+		{
+			name:      "zero quantity",
+			accountID: accountID,
+			products:  []map[string]any{{"id": productID, "quantity": 0}},
+			wantErr:   "greater than zero",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := createOrder(h.Client, tt.accountID, tt.products)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("createOrder error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if orders := queryOrders(t, h.Client, accountID); len(orders) != 0 {
+		t.Errorf("failed orders were stored: %+v", orders)
+	}
+}
+
+func TestOrdersAreScopedToAccount(t *testing.T) {
+	h := New(t)
+	productID := createProduct(t, h, product{"Lamp", 30})
+	alice := createAccount(t, h, "Alice")
+	bob := createAccount(t, h, "Bob")
+
+	if _, err := createOrder(h.Client, alice, []map[string]any{{"id": productID, "quantity": 1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(queryOrders(t, h.Client, alice)); got != 1 {
+		t.Errorf("alice has %d orders, want 1", got)
+	}
+	if got := len(queryOrders(t, h.Client, bob)); got != 0 {
+		t.Errorf("bob has %d orders, want 0", got)
+	}
+
+	var resp struct {
+		Accounts []struct {
+			ID     string
+			Orders []order
+		}
+	}
+	h.Client.MustPost(`query($id: String) { accounts(id: $id) { id orders { `+orderFields+` } } }`, &resp, client.Var("id", alice))
+	if len(resp.Accounts) != 1 || len(resp.Accounts[0].Orders) != 1 {
+		t.Fatalf("accounts(id: alice) = %+v, want alice with one order", resp.Accounts)
+	}
+}
+
+func TestCheckoutKeyPlacesOneOrder(t *testing.T) {
+	h := New(t)
+	ctx := tenant.NewContext(t.Context(), Tenant)
+	accountID := createAccount(t, h, "Edsger")
+	productID := createProduct(t, h, product{"Desk", 120})
+
+	input := &orderDTO.Order{
+		AccountId:   accountID,
+		CheckoutKey: "checkout",
+		Catalogs:    []*orderDTO.OrderedCatalog{{Id: productID, Quantity: 1}},
+	}
+	first, err := h.OrderClient.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := h.OrderClient.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Id != first.Id {
+		t.Errorf("retried checkout placed order %s, want %s", again.Id, first.Id)
+	}
+	if orders := queryOrders(t, h.Client, accountID); len(orders) != 1 {
+		t.Errorf("account has %d orders, want 1", len(orders))
+	}
+}
+
+func TestOrdersOfManyProducts(t *testing.T) {
+	h := New(t)
+	accountID := createAccount(t, h, "Frances")
+
+	// More products than the catalog service looks up at once.
+	const products = 51
+	for i := range products {
+		productID := createProduct(t, h, product{fmt.Sprintf("Print %d", i), 15})
+		if _, err := createOrder(h.Client, accountID, []map[string]any{{"id": productID, "quantity": 1}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var resp struct {
+		Orders []order
+	}
+	h.Client.MustPost(`query($accountId: String!) {
+  orders(order: {accountId: $accountId, products: []}, first: 100) { `+orderFields+` }
+}`, &resp, client.Var("accountId", accountID))
+	if len(resp.Orders) != products {
+		t.Fatalf("account has %d orders, want %d", len(resp.Orders), products)
+	}
+	for _, o := range resp.Orders {
+		if len(o.Products) != 1 || !strings.HasPrefix(o.Products[0].Name, "Print ") {
+			t.Errorf("order %s has products %+v, want one named print", o.ID, o.Products)
+		}
+	}
+}
+
+func TestShipmentRejectsDuplicateLines(t *testing.T) {
+	h := New(t)
+	ctx := tenant.NewContext(t.Context(), Tenant)
+	accountID := createAccount(t, h, "Barbara")
+	productID := createProduct(t, h, product{"Shelf", 45})
+	placed, err := createOrder(h.Client, accountID, []map[string]any{{"id": productID, "quantity": 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = h.OrderClient.CreateShipment(ctx, &orderDTO.CreateShipment{
+		OrderId:        placed.ID,
+		Carrier:        "DHL",
+		TrackingNumber: "JD014600006281230704",
+		Lines: []*orderDTO.ShipmentLine{
+			{CatalogId: productID, Quantity: 1},
+			{CatalogId: productID, Quantity: 1},
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateShipment with a product on two lines: error = %v, want InvalidArgument", err)
+	}
+}
+
+func keys(m map[string]order) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,199 @@
+// Package e2e runs the account, catalog and order gRPC servers and the
+// GraphQL gateway in one process, on in-memory repositories and bufconn
+// listeners, so that tests can drive a request through every service
+// without Postgres, Elasticsearch or open ports. Tests that depend on the
+// databases, such as those of tenant isolation, can run on them too; see
+// Stored.
+//
+// Only the account, catalog and order repositories are backed. Flows that
+// reach addresses, payments, returns, shipments, reports or invoices are
+// out of the harness's reach.
+//
+// The gateway serves two tenants, each on its own host, the way a
+// deployment serves several storefronts.
+package e2e
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	accountProto "github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/proto"
+	accountRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
+	accountService "github.com/saleh-ghazimoradi/MircoEcoMarket/account/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	catalogProto "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/proto"
+	catalogRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/repository"
+	catalogService "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/events"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	orderProto "github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/invoice"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/payment"
+	orderRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	orderService "github.com/saleh-ghazimoradi/MircoEcoMarket/order/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/requestid"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1 << 20
+
+// AdminToken is the bearer token of admin requests to Handler.
+const AdminToken = "e2e-admin"
+
+// The tenants of the gateway and the hosts that serve them. Other hosts
+// belong to no tenant.
+const (
+	Tenant      = "acme"
+	Host        = "acme.example.com"
+	OtherTenant = "globex"
+	OtherHost   = "globex.example.com"
+)
+
+// Repositories are the repositories behind the services.
+type Repositories struct {
+	Accounts accountRepository.AccountRepository
+	Catalogs catalogRepository.CatalogRepository
+	Orders   orderRepository.OrderRepository
+}
+
+// Memory returns empty in-memory repositories.
+func Memory() Repositories {
+	return Repositories{
+		Accounts: accountRepository.NewMemoryAccountRepository(),
+		Catalogs: catalogRepository.NewMemoryCatalogRepository(),
+		Orders:   orderRepository.NewMemoryOrderRepository(),
+	}
+}
+
+type Harness struct {
+	// The repositories behind the services, for seeding and inspecting state
+	// directly.
+	Repositories
+
+	AccountClient accountHandler.GRPCAccountClient
+	CatalogClient catalogHandler.GRPCCatalogClient
+	OrderClient   orderHandler.GRPCOrderClient
+
+	// Handler serves /query of the gateway; Client posts to it as Host.
+	Handler http.Handler
+	Client  *client.Client
+}
+
+// ClientFor returns a client that posts to Handler as host.
+func (h *Harness) ClientFor(host string) *client.Client {
+	return client.New(h.Handler, func(r *client.Request) {
+		r.HTTP.Host = host
+	})
+}
+
+// server is what the harness needs of each service's gRPC server.
+type server interface {
+	ServeListener(lis net.Listener) error
+	Stop() error
+}
+
+// New starts the services and gateway on in-memory repositories, stopping
+// them when tb finishes.
+func New(tb testing.TB) *Harness {
+	tb.Helper()
+	return NewWith(tb, Memory())
+}
+
+// NewWith is New on repositories.
+func NewWith(tb testing.TB, repositories Repositories) *Harness {
+	tb.Helper()
+
+	creds, err := mtls.NewCredentials(mtls.Config{})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	breaker := func(name string) *grpcx.Breaker {
+		return grpcx.NewBreaker(name, 5, time.Second)
+	}
+
+	h := &Harness{Repositories: repositories}
+
+	accounts := accountService.NewAccountService(h.Accounts)
+	addresses := accountService.NewAddressService(h.Accounts, nil)
+	accountDial := serve(tb, accountHandler.NewGRPCServer(accounts, addresses, checker(accountProto.AccountService_ServiceDesc.ServiceName), false, creds))
+	h.AccountClient, err = accountHandler.NewGRPCAccountClient("passthrough:///account", creds, grpcx.RetryPolicy{}, breaker("account"), accountDial)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { h.AccountClient.Close() })
+
+	catalogs := catalogService.NewCatalogService(h.Catalogs)
+	catalogDial := serve(tb, catalogHandler.NewGRPCCatalogServer(catalogs, checker(catalogProto.CatalogService_ServiceDesc.ServiceName), false, creds))
+	h.CatalogClient, err = catalogHandler.NewGRPCCatalogClient("passthrough:///catalog", creds, grpcx.RetryPolicy{}, breaker("catalog"), catalogDial)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { h.CatalogClient.Close() })
+
+	broadcaster := events.NewBroadcaster()
+	orderRepo := events.NewOrderRepository(h.Orders, broadcaster)
+	invoices := orderService.NewInvoiceService("e2e", 0, invoice.NewRenderer(), orderRepo, nil)
+	payments := orderService.NewPaymentService(payment.NewFakeProvider("e2e"), orderRepo, nil, invoices)
+	orders := orderService.NewOrderService(orderRepo, nil, payments)
+	returns := orderService.NewReturnService(orderRepo, nil, payments)
+	shipments := orderService.NewShipmentService(orderRepo, nil)
+	reports := orderService.NewReportService(nil)
+	orderDial := serve(tb, orderHandler.NewGRPCOrderServer(orders, payments, returns, shipments, reports, invoices, broadcaster, h.AccountClient, h.CatalogClient, checker(orderProto.OrderService_ServiceDesc.ServiceName), false, creds))
+	h.OrderClient, err = orderHandler.NewGRPCOrderClient("passthrough:///order", creds, grpcx.RetryPolicy{}, breaker("order"), orderDial)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { h.OrderClient.Close() })
+
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  &graph.Resolver{AccountClient: h.AccountClient, CatalogClient: h.CatalogClient, OrderClient: h.OrderClient},
+		Directives: graph.DirectiveRoot{Admin: graph.Admin},
+		Complexity: graph.NewComplexityRoot(),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+	srv.SetRecoverFunc(graph.RecoverFunc)
+	hosts := tenant.Config{Hosts: map[string]string{Host: Tenant, OtherHost: OtherTenant}}
+	h.Handler = requestid.Middleware(tenant.Middleware(hosts)(postgres.Middleware(auth.Middleware(auth.Credentials{AdminToken: AdminToken})(srv))))
+	h.Client = h.ClientFor(Host)
+	return h
+}
+
+func checker(service string) *health.Checker {
+	return health.NewChecker(service, map[string]health.Check{}, nil)
+}
+
+// serve starts s on a bufconn listener and returns the dial option of
+// clients to connect to it.
+func serve(tb testing.TB, s server) grpc.DialOption {
+	tb.Helper()
+	lis := bufconn.Listen(bufSize)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.ServeListener(lis)
+	}()
+	tb.Cleanup(func() {
+		s.Stop()
+		// Closing the listener also ends a server stopped before it started.
+		lis.Close()
+		<-done
+	})
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+}
@@ -0,0 +1,239 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	accountDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	accountDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	accountRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
+	catalogDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
+	catalogDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	catalogRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/repository"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	orderRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ids are records that one tenant made through the gateway.
+type ids struct {
+	account, product, order string
+}
+
+func seedTenant(t *testing.T, c *client.Client, name string) ids {
+	t.Helper()
+	var resp struct {
+		CreateAccount struct{ ID string }
+		CreateProduct struct{ ID string }
+	}
+	c.MustPost(`mutation($name: String!) {
+  createAccount(account: {name: $name}) { id }
+  createProduct(product: {name: $name, description: "Sold by one tenant only", price: 10}) { id }
+}`, &resp, client.Var("name", name))
+
+	var created struct{ CreateOrder order }
+	c.MustPost(`mutation($accountId: String!, $productId: String!) {
+  createOrder(order: {accountId: $accountId, products: [{id: $productId, quantity: 1}]}) { id }
+}`, &created, client.Var("accountId", resp.CreateAccount.ID), client.Var("productId", resp.CreateProduct.ID))
+	return ids{account: resp.CreateAccount.ID, product: resp.CreateProduct.ID, order: created.CreateOrder.ID}
+}
+
+// backends are the repositories tenant isolation is tested on: memory, and
+// the databases of Stored when their servers are set up.
+var backends = []struct {
+	name         string
+	repositories func(testing.TB) Repositories
+	// errMissing is the error of writes without a tenant; nil takes any.
+	// The databases refuse them with errors of their own.
+	errMissing error
+}{
+	{"memory", func(testing.TB) Repositories { return Memory() }, tenant.ErrMissing},
+	{"stored", Stored, nil},
+}
+
+func TestTenantsDontReadEachOther(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			testTenantsDontReadEachOther(t, NewWith(t, b.repositories(t)))
+		})
+	}
+}
+
+func testTenantsDontReadEachOther(t *testing.T, h *Harness) {
+	acme := seedTenant(t, h.Client, "Acme")
+	globexClient := h.ClientFor(OtherHost)
+	globex := seedTenant(t, globexClient, "Globex")
+
+	for _, tt := range []struct {
+		name   string
+		client *client.Client
+		own    ids
+		other  ids
+	}{
+		{"acme", h.Client, acme, globex},
+		{"globex", globexClient, globex, acme},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var lists struct {
+				Accounts []struct{ ID string }
+				Products []struct{ ID string }
+			}
+			tt.client.MustPost(`{ accounts { id } products { id } }`, &lists)
+			if len(lists.Accounts) != 1 || lists.Accounts[0].ID != tt.own.account {
+				t.Errorf("accounts = %+v, want only %s", lists.Accounts, tt.own.account)
+			}
+			if len(lists.Products) != 1 || lists.Products[0].ID != tt.own.product {
+				t.Errorf("products = %+v, want only %s", lists.Products, tt.own.product)
+			}
+
+			var search struct {
+				Products []struct{ ID string }
+			}
+			tt.client.MustPost(`{ products(query: "tenant") { id } }`, &search)
+			if len(search.Products) != 1 || search.Products[0].ID != tt.own.product {
+				t.Errorf("products matching the shared description = %+v, want only %s", search.Products, tt.own.product)
+			}
+
+			for _, q := range []struct {
+				name, query, id string
+			}{
+				{"account", `query($id: String) { accounts(id: $id) { id } }`, tt.other.account},
+				{"product", `query($id: String) { products(id: $id) { id } }`, tt.other.product},
+			} {
+				var resp map[string]any
+				err := tt.client.Post(q.query, &resp, client.Var("id", q.id))
+				if err == nil || !strings.Contains(err.Error(), "NOT_FOUND") {
+					t.Errorf("%s of the other tenant: error = %v, want NOT_FOUND", q.name, err)
+				}
+			}
+
+			if orders := queryOrders(t, tt.client, tt.other.account); len(orders) != 0 {
+				t.Errorf("orders of the other tenant's account = %+v, want none", orders)
+			}
+			if orders := queryOrders(t, tt.client, tt.own.account); len(orders) != 1 || orders[0].ID != tt.own.order {
+				t.Errorf("own orders = %+v, want %s", orders, tt.own.order)
+			}
+
+			_, err := createOrder(tt.client, tt.other.account, []map[string]any{{"id": tt.own.product, "quantity": 1}})
+			if err == nil || !strings.Contains(err.Error(), "NOT_FOUND") {
+				t.Errorf("ordering for the other tenant's account: error = %v, want NOT_FOUND", err)
+			}
+			// Products the catalog doesn't know are left out of an order.
+			if o, err := createOrder(tt.client, tt.own.account, []map[string]any{{"id": tt.other.product, "quantity": 1}}); err == nil && (len(o.Products) > 0 || o.TotalPrice != 0) {
+				t.Errorf("order of the other tenant's product = %+v, want it left out", o)
+			}
+		})
+	}
+}
+
+func TestRepositoriesAreScopedToTenant(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			testRepositoriesAreScopedToTenant(t, NewWith(t, b.repositories(t)), b.errMissing)
+		})
+	}
+}
+
+func testRepositoriesAreScopedToTenant(t *testing.T, h *Harness, errMissing error) {
+	acme := tenant.NewContext(t.Context(), Tenant)
+	globex := tenant.NewContext(t.Context(), OtherTenant)
+
+	if err := h.Accounts.CreateAccount(acme, &accountDomain.Account{Id: "account", Name: "Ada"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Catalogs.CreateCatalog(acme, &catalogDomain.Catalog{Id: "product", Name: "Lamp", Price: 30}); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Orders.CreateOrder(acme, &orderDomain.Order{Id: "order", AccountId: "account", Status: orderDomain.OrderStatusPending}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name       string
+		ctx        context.Context
+		catalogErr error
+	}{
+		{"other tenant", globex, catalogRepository.ErrNotFound},
+		{"no tenant", t.Context(), tenant.ErrMissing},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := h.Accounts.GetAccountById(tt.ctx, "account"); !errors.Is(err, accountRepository.ErrNoRows) {
+				t.Errorf("GetAccountById error = %v, want ErrNoRows", err)
+			}
+			if accounts, _ := h.Accounts.GetAccounts(tt.ctx, &accountDTO.AccountQuery{Limit: 10}); len(accounts) != 0 {
+				t.Errorf("GetAccounts = %+v, want none", accounts)
+			}
+			if accounts, _ := h.Accounts.GetAccountsByIds(tt.ctx, []string{"account"}); len(accounts) != 0 {
+				t.Errorf("GetAccountsByIds = %+v, want none", accounts)
+			}
+
+			// Unlike Postgres, the catalog index can't hide documents from a
+			// context without a tenant, so the repository refuses it.
+			if _, err := h.Catalogs.GetCatalogById(tt.ctx, "product"); !errors.Is(err, tt.catalogErr) {
+				t.Errorf("GetCatalogById error = %v, want %v", err, tt.catalogErr)
+			}
+			if catalogs, _ := h.Catalogs.GetCatalogs(tt.ctx, &catalogDTO.CatalogQuery{Limit: 10}); len(catalogs) != 0 {
+				t.Errorf("GetCatalogs = %+v, want none", catalogs)
+			}
+			if catalogs, _ := h.Catalogs.GetCatalogsByIds(tt.ctx, []string{"product"}); len(catalogs) != 0 {
+				t.Errorf("GetCatalogsByIds = %+v, want none", catalogs)
+			}
+
+			if _, err := h.Orders.GetOrderById(tt.ctx, "order"); !errors.Is(err, orderRepository.ErrNoRows) {
+				t.Errorf("GetOrderById error = %v, want ErrNoRows", err)
+			}
+			if orders, _ := h.Orders.GetOrdersByIds(tt.ctx, []string{"order"}); len(orders) != 0 {
+				t.Errorf("GetOrdersByIds = %+v, want none", orders)
+			}
+			if err := h.Orders.UpdateOrderStatus(tt.ctx, "order", orderDomain.OrderStatusCancelled); !errors.Is(err, orderRepository.ErrNoRows) {
+				t.Errorf("UpdateOrderStatus error = %v, want ErrNoRows", err)
+			}
+		})
+	}
+
+	refused := func(err error) bool {
+		if errMissing == nil {
+			return err != nil
+		}
+		return errors.Is(err, errMissing)
+	}
+	if err := h.Accounts.CreateAccount(t.Context(), &accountDomain.Account{Id: "orphan", Name: "Nobody"}); !refused(err) {
+		t.Errorf("CreateAccount without a tenant: error = %v, want it refused", err)
+	}
+	if err := h.Catalogs.CreateCatalog(t.Context(), &catalogDomain.Catalog{Id: "orphan"}); !errors.Is(err, tenant.ErrMissing) {
+		t.Errorf("CreateCatalog without a tenant: error = %v, want ErrMissing", err)
+	}
+	if err := h.Orders.CreateOrder(t.Context(), &orderDomain.Order{Id: "orphan"}); !refused(err) {
+		t.Errorf("CreateOrder without a tenant: error = %v, want it refused", err)
+	}
+
+	stored, err := h.Orders.GetOrderById(acme, "order")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != orderDomain.OrderStatusPending {
+		t.Errorf("order status = %q after updates from other tenants, want pending", stored.Status)
+	}
+}
+
+func TestServicesRequireTenant(t *testing.T) {
+	h := New(t)
+	_, err := h.AccountClient.GetAccounts(t.Context(), &accountDTO.AccountQuery{Limit: 10})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("call without a tenant: error = %v, want Unauthenticated", err)
+	}
+	if _, err := h.AccountClient.GetAccounts(tenant.NewContext(t.Context(), Tenant), &accountDTO.AccountQuery{Limit: 10}); err != nil {
+		t.Errorf("call with a tenant: %v", err)
+	}
+
+	var resp map[string]any
+	err = h.ClientFor("unknown.example.com").Post(`{ accounts { id } }`, &resp)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("request to an unknown host: error = %v, want 404", err)
+	}
+}
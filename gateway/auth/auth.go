@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrForbidden       = errors.New("forbidden: admin access required")
+	ErrNotOwner        = errors.New("forbidden: the account is not the caller's")
+	ErrInvalidToken    = errors.New("invalid authorization token")
+	ErrUnauthenticated = errors.New("authentication required")
+)
+
+type adminKey struct{}
+
+type accountKey struct{}
+
+// Credentials are what bearer tokens are checked against. An empty
+// AdminToken disables admin access entirely, and an empty AccountSecret
+// signing in as an account.
+type Credentials struct {
+	AdminToken string
+	// AccountSecret signs the tokens of AccountToken.
+	AccountSecret string
+}
+
+// Authenticate checks an Authorization value ("Bearer <token>"). The admin
+// token marks ctx as admin, and an account token signs ctx in as its
+// account. A missing value is anonymous; any other token is ErrInvalidToken.
+func Authenticate(ctx context.Context, authorization string, credentials Credentials) (context.Context, error) {
+	if authorization == "" {
+		return ctx, nil
+	}
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return ctx, ErrInvalidToken
+	}
+	if credentials.AdminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(credentials.AdminToken)) == 1 {
+		return context.WithValue(ctx, adminKey{}, true), nil
+	}
+	if accountID, ok := verify(token, credentials.AccountSecret); ok {
+		return context.WithValue(ctx, accountKey{}, accountID), nil
+	}
+	return ctx, ErrInvalidToken
+}
+
+// SignIn is Authenticate for connections that only signed-in callers may
+// open: an anonymous value is ErrUnauthenticated.
+func SignIn(ctx context.Context, authorization string, credentials Credentials) (context.Context, error) {
+	ctx, err := Authenticate(ctx, authorization, credentials)
+	if err == nil && !IsAdmin(ctx) && AccountID(ctx) == "" {
+		err = ErrUnauthenticated
+	}
+	return ctx, err
+}
+
+// Middleware marks requests that carry the admin bearer token, and signs in
+// those that carry an account token. Requests with any other token are
+// served anonymously.
+func Middleware(credentials Credentials) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, _ := Authenticate(r.Context(), r.Header.Get("Authorization"), credentials)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AccountToken returns the bearer token that signs a caller in as
+// accountID. Whatever signs customers in issues it with the gateway's
+// AccountSecret; tokens don't expire, so rotating the secret is what
+// revokes them.
+func AccountToken(secret, accountID string) string {
+	return accountID + "." + sign(secret, accountID)
+}
+
+func sign(secret, accountID string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(accountID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify returns the account of an AccountToken signed with secret.
+func verify(token, secret string) (string, bool) {
+	if secret == "" {
+		return "", false
+	}
+	accountID, signature, ok := strings.Cut(token, ".")
+	if !ok || accountID == "" {
+		return "", false
+	}
+	return accountID, hmac.Equal([]byte(signature), []byte(sign(secret, accountID)))
+}
+
+func IsAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminKey{}).(bool)
+	return admin
+}
+
+// AccountID returns the account ctx is signed in as, or "" when it is
+// anonymous or admin.
+func AccountID(ctx context.Context) string {
+	accountID, _ := ctx.Value(accountKey{}).(string)
+	return accountID
+}
+
+// Owns reports whether ctx may act for accountID: admins for any account,
+// customers for their own.
+func Owns(ctx context.Context, accountID string) bool {
+	return IsAdmin(ctx) || (accountID != "" && AccountID(ctx) == accountID)
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestAuthenticate(t *testing.T) {
+	credentials := Credentials{AdminToken: "staff", AccountSecret: "secret"}
+	tests := []struct {
+		name          string
+		authorization string
+		wantErr       error
+		wantAdmin     bool
+		wantAccount   string
+	}{
+		{name: "anonymous"},
+		{name: "admin", authorization: "Bearer staff", wantAdmin: true},
+		{name: "account", authorization: "Bearer " + AccountToken("secret", "alice"), wantAccount: "alice"},
+		{name: "other secret", authorization: "Bearer " + AccountToken("guess", "alice"), wantErr: ErrInvalidToken},
+		{name: "other account", authorization: "Bearer bob." + AccountToken("secret", "alice")[len("alice."):], wantErr: ErrInvalidToken},
+		{name: "unsigned", authorization: "Bearer alice", wantErr: ErrInvalidToken},
+		{name: "not bearer", authorization: "Basic staff", wantErr: ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := Authenticate(context.Background(), tt.authorization, credentials)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if IsAdmin(ctx) != tt.wantAdmin || AccountID(ctx) != tt.wantAccount {
+				t.Errorf("admin = %v, account = %q; want %v, %q", IsAdmin(ctx), AccountID(ctx), tt.wantAdmin, tt.wantAccount)
+			}
+		})
+	}
+}
+
+func TestAccountTokensNeedASecret(t *testing.T) {
+	_, err := Authenticate(context.Background(), "Bearer "+AccountToken("", "alice"), Credentials{AdminToken: "staff"})
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestSignInRefusesAnonymous(t *testing.T) {
+	credentials := Credentials{AdminToken: "staff", AccountSecret: "secret"}
+	if _, err := SignIn(context.Background(), "", credentials); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("anonymous: error = %v, want %v", err, ErrUnauthenticated)
+	}
+	ctx, err := SignIn(context.Background(), "Bearer "+AccountToken("secret", "alice"), credentials)
+	if err != nil || AccountID(ctx) != "alice" {
+		t.Errorf("account token: account = %q, error = %v; want alice", AccountID(ctx), err)
+	}
+}
+
+func TestOwns(t *testing.T) {
+	credentials := Credentials{AdminToken: "staff", AccountSecret: "secret"}
+	admin, _ := Authenticate(context.Background(), "Bearer staff", credentials)
+	alice, _ := Authenticate(context.Background(), "Bearer "+AccountToken("secret", "alice"), credentials)
+
+	for _, tt := range []struct {
+		name      string
+		ctx       context.Context
+		accountID string
+		want      bool
+	}{
+		{"admin", admin, "bob", true},
+		{"own account", alice, "alice", true},
+		{"other account", alice, "bob", false},
+		{"anonymous", context.Background(), "", false},
+	} {
+		if got := Owns(tt.ctx, tt.accountID); got != tt.want {
+			t.Errorf("%s: Owns(%q) = %v, want %v", tt.name, tt.accountID, got, tt.want)
+		}
+	}
+}
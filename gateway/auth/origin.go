@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// CheckOrigin returns a websocket origin check that accepts pages served from
+// the gateway's own host and from the allowed origins ("https://shop.example.com").
+// Other pages could otherwise open sockets with their visitors' credentials.
+// Requests without an Origin header don't come from browsers and are accepted.
+func CheckOrigin(allowed []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		return slices.ContainsFunc(allowed, func(a string) bool {
+			return strings.EqualFold(strings.TrimSuffix(a, "/"), origin)
+		})
+	}
+}
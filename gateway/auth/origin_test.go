@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	check := CheckOrigin([]string{"https://shop.example.com/"})
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{origin: "", want: true},
+		{origin: "https://gateway.example.com", want: true},
+		{origin: "https://shop.example.com", want: true},
+		{origin: "HTTPS://SHOP.example.com", want: true},
+		{origin: "http://shop.example.com", want: false},
+		{origin: "https://evil.example.com", want: false},
+		{origin: "://", want: false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "http://gateway.example.com/query", nil)
+		if tt.origin != "" {
+			r.Header.Set("Origin", tt.origin)
+		}
+		if got := check(r); got != tt.want {
+			t.Errorf("origin %q allowed = %v, want %v", tt.origin, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Command pqmanifest extracts the GraphQL operations of client source trees
+// into a persisted query manifest for the gateway's allowlist mode.
+//
+//	go run ./gateway/cmd/pqmanifest -schema 'gateway/graph/*.graphqls' -out manifest.json ./ios ./android ./web
+//
+// It reads .graphql and .gql files whole, and gql`...` tagged templates from
+// JavaScript and TypeScript sources. Each named operation is stored together
+// with the fragments it uses and validated against the schema.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/persisted"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+var (
+	gqlTemplate   = regexp.MustCompile("(?s)\\bgql\\s*`(.*?)`")
+	interpolation = regexp.MustCompile(`\$\{[^}]*\}`)
+)
+
+func main() {
+	schemaGlob := flag.String("schema", "gateway/graph/*.graphqls", "glob of the schema files to validate against")
+	out := flag.String("out", "persisted-queries.json", "manifest file to write")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("usage: pqmanifest [-schema glob] [-out file] dir...")
+	}
+
+	schema, err := loadSchema(*schemaGlob)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	operations := make(map[string]*ast.OperationDefinition)
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, dir := range flag.Args() {
+		if err := collect(dir, operations, fragments); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	manifest := &persisted.Manifest{
+		Format:  persisted.ManifestFormat,
+		Version: persisted.ManifestVersion,
+	}
+	for _, name := range slices.Sorted(maps.Keys(operations)) {
+		op := operations[name]
+		body, err := document(op, fragments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, errs := gqlparser.LoadQuery(schema, body); len(errs) > 0 {
+			log.Fatalf("operation %s: %v", name, errs)
+		}
+		manifest.Operations = append(manifest.Operations, &persisted.Operation{
+			Id:   persisted.Hash(body),
+			Name: name,
+			Type: string(op.Operation),
+			Body: body,
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, append(data, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d operations to %s", len(manifest.Operations), *out)
+}
+
+func loadSchema(glob string) (*ast.Schema, error) {
+	paths, err := filepath.Glob(glob)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no schema files match %s", glob)
+	}
+	var sources []*ast.Source
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, &ast.Source{Name: path, Input: string(data)})
+	}
+	return gqlparser.LoadSchema(sources...)
+}
+
+// collect parses every GraphQL document under dir. Operation and fragment
+// names must be unique across all sources, as clients refer to them by name.
+func collect(dir string, operations map[string]*ast.OperationDefinition, fragments map[string]*ast.FragmentDefinition) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "node_modules" || strings.HasPrefix(d.Name(), ".") && path != dir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		sources, err := extract(path)
+		if err != nil {
+			return err
+		}
+		for _, source := range sources {
+			doc, err := parser.ParseQuery(&ast.Source{Name: path, Input: source})
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			for _, op := range doc.Operations {
+				if op.Name == "" {
+					return fmt.Errorf("%s: anonymous operations can't be persisted", path)
+				}
+				if _, ok := operations[op.Name]; ok {
+					return fmt.Errorf("%s: duplicate operation %s", path, op.Name)
+				}
+				operations[op.Name] = op
+			}
+			for _, fragment := range doc.Fragments {
+				if _, ok := fragments[fragment.Name]; ok {
+					return fmt.Errorf("%s: duplicate fragment %s", path, fragment.Name)
+				}
+				fragments[fragment.Name] = fragment
+			}
+		}
+		return nil
+	})
+}
+
+func extract(path string) ([]string, error) {
+	switch filepath.Ext(path) {
+	case ".graphql", ".gql":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return []string{string(data)}, nil
+	case ".js", ".jsx", ".ts", ".tsx":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var sources []string
+		for _, match := range gqlTemplate.FindAllStringSubmatch(string(data), -1) {
+			// Interpolated fragments are found by name among all sources.
+			sources = append(sources, interpolation.ReplaceAllString(match[1], ""))
+		}
+		return sources, nil
+	default:
+		return nil, nil
+	}
+}
+
+// document prints an operation together with the fragments it uses, in
+// name order, so the same sources always produce the same body and hash.
+func document(op *ast.OperationDefinition, fragments map[string]*ast.FragmentDefinition) (string, error) {
+	used := make(map[string]*ast.FragmentDefinition)
+	var visit func(ast.SelectionSet) error
+	visit = func(selectionSet ast.SelectionSet) error {
+		for _, selection := range selectionSet {
+			switch s := selection.(type) {
+			case *ast.Field:
+				if err := visit(s.SelectionSet); err != nil {
+					return err
+				}
+			case *ast.InlineFragment:
+				if err := visit(s.SelectionSet); err != nil {
+					return err
+				}
+			case *ast.FragmentSpread:
+				if _, ok := used[s.Name]; ok {
+					continue
+				}
+				fragment, ok := fragments[s.Name]
+				if !ok {
+					return fmt.Errorf("operation %s: unknown fragment %s", op.Name, s.Name)
+				}
+				used[s.Name] = fragment
+				if err := visit(fragment.SelectionSet); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := visit(op.SelectionSet); err != nil {
+		return "", err
+	}
+
+	doc := &ast.QueryDocument{Operations: ast.OperationList{op}}
+	for _, name := range slices.Sorted(maps.Keys(used)) {
+		doc.Fragments = append(doc.Fragments, used[name])
+	}
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatQueryDocument(doc)
+	return buf.String(), nil
+}
@@ -4,4 +4,6 @@ type Application struct {
 	AccountPort string `env:"ACCOUNT_PORT"`
 	CatalogPort string `env:"CATALOG_PORT"`
 	OrderPort   string `env:"ORDER_PORT"`
+	CartPort    string `env:"CART_PORT"`
+	MetricsPort string `env:"METRICS_PORT" envDefault:":9100"`
 }
@@ -0,0 +1,11 @@
+package config
+
+type Auth struct {
+	AdminToken string `env:"GATEWAY_ADMIN_TOKEN"`
+	// AccountSecret signs the bearer tokens of customers; see
+	// auth.AccountToken. Unset, only admins can sign in.
+	AccountSecret string `env:"GATEWAY_ACCOUNT_SECRET"`
+	// WebsocketOrigins lists the origins, besides the gateway's own, whose
+	// pages may open subscription sockets.
+	WebsocketOrigins []string `env:"GATEWAY_WEBSOCKET_ORIGINS" envSeparator:","`
+}
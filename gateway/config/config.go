@@ -1,7 +1,8 @@
 package config
 
 import (
-	"github.com/caarlos0/env/v11"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/configx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
 	"sync"
 )
 
@@ -12,13 +13,20 @@ var (
 )
 
 type Config struct {
-	Application Application
+	Application      Application
+	Auth             Auth
+	Tenant           tenant.Config
+	Limits           Limits
+	PersistedQueries PersistedQueries
+	Tracing          Tracing
+	TLS              TLS
+	Resilience       Resilience
 }
 
 func NewConfig() (*Config, error) {
 	once.Do(func() {
 		instance = &Config{}
-		initErr = env.Parse(instance)
+		initErr = configx.Load(instance)
 		if initErr != nil {
 			instance = nil
 		}
@@ -0,0 +1,6 @@
+package config
+
+type PersistedQueries struct {
+	ManifestPath string `env:"PERSISTED_QUERIES_MANIFEST"`
+	Enforce      bool   `env:"PERSISTED_QUERIES_ENFORCE"`
+}
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type Resilience struct {
+	RetryMaxAttempts    int           `env:"GRPC_RETRY_MAX_ATTEMPTS" envDefault:"3"`
+	RetryInitialBackoff time.Duration `env:"GRPC_RETRY_INITIAL_BACKOFF" envDefault:"100ms"`
+	RetryMaxBackoff     time.Duration `env:"GRPC_RETRY_MAX_BACKOFF" envDefault:"1s"`
+	BreakerFailures     int           `env:"GRPC_BREAKER_FAILURES" envDefault:"5"`
+	BreakerCooldown     time.Duration `env:"GRPC_BREAKER_COOLDOWN" envDefault:"10s"`
+}
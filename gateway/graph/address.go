@@ -0,0 +1,51 @@
+package graph
+
+import (
+	accountDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	accountDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+)
+
+func toAddressModel(address *accountDomain.Address) *model.Address {
+	return &model.Address{
+		ID:         address.Id,
+		AccountID:  address.AccountId,
+		Recipient:  address.Recipient,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+		Phone:      address.Phone,
+		IsDefault:  address.IsDefault,
+		CreatedAt:  address.CreatedAt,
+		UpdatedAt:  address.UpdatedAt,
+	}
+}
+
+func toAddressDTO(id string, input model.AddressInput) *accountDTO.Address {
+	address := &accountDTO.Address{
+		Id:         id,
+		AccountId:  input.AccountID,
+		Recipient:  input.Recipient,
+		Line1:      input.Line1,
+		Line2:      deref(input.Line2),
+		City:       input.City,
+		Region:     deref(input.Region),
+		PostalCode: input.PostalCode,
+		Country:    input.Country,
+		Phone:      deref(input.Phone),
+	}
+	if input.IsDefault != nil {
+		address.IsDefault = *input.IsDefault
+	}
+	return address
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
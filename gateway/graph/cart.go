@@ -0,0 +1,33 @@
+package graph
+
+import (
+	cartDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+)
+
+func toCartModel(cart *cartDomain.Cart) *model.Cart {
+	var accountID *string
+	if cart.AccountId != "" {
+		accountID = &cart.AccountId
+	}
+
+	items := make([]*model.CartItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, &model.CartItem{
+			ID:          item.CatalogId,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			Quantity:    int32(item.Quantity),
+		})
+	}
+
+	return &model.Cart{
+		ID:         cart.Id,
+		AccountID:  accountID,
+		CreatedAt:  cart.CreatedAt,
+		UpdatedAt:  cart.UpdatedAt,
+		TotalPrice: cart.TotalPrice,
+		Items:      items,
+	}
+}
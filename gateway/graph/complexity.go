@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+)
+
+// Page sizes the services fall back to when no limit is given, and the most
+// any of them returns. List fields cost their children once per item.
+const (
+	defaultAccountPage = 100
+	defaultProductPage = 10
+	defaultOrderPage   = 20
+	maxPage            = 100
+)
+
+// rpcCost is charged for fields resolved by their own call to a service, so
+// they count even when few subfields are selected.
+const rpcCost = 5
+
+// NewComplexityRoot prices the fields that fan out into service calls.
+// Fields not set here cost 1 plus their children.
+func NewComplexityRoot() ComplexityRoot {
+	var c ComplexityRoot
+
+	c.Query.Accounts = func(childComplexity int, pagination *model.PaginationInput, id *string) int {
+		if id != nil {
+			return rpcCost + childComplexity
+		}
+		return listCost(childComplexity, paginationLimit(pagination), defaultAccountPage)
+	}
+	c.Query.Products = func(childComplexity int, pagination *model.PaginationInput, query *string, id *string) int {
+		if id != nil {
+			return rpcCost + childComplexity
+		}
+		return listCost(childComplexity, paginationLimit(pagination), defaultProductPage)
+	}
+	c.Query.Orders = func(childComplexity int, order model.OrderInput, filter *model.OrderFilterInput, sort *model.SortDirection, first *int32, after *string) int {
+		return listCost(childComplexity, first, defaultOrderPage)
+	}
+	c.Account.Orders = func(childComplexity int, filter *model.OrderFilterInput, sort *model.SortDirection, first *int32, after *string) int {
+		return listCost(childComplexity, first, defaultOrderPage)
+	}
+
+	c.Account.Addresses = fieldRPCCost
+	c.Order.Returns = fieldRPCCost
+	c.Order.Shipments = fieldRPCCost
+	c.Order.Invoice = fieldRPCCost
+	return c
+}
+
+func fieldRPCCost(childComplexity int) int {
+	return saturatingAdd(rpcCost, childComplexity)
+}
+
+// listCost is the cost of one service call returning up to limit items, or
+// defaultLimit items when no usable limit is given.
+func listCost(childComplexity int, limit *int32, defaultLimit int) int {
+	size := defaultLimit
+	if limit != nil && *limit > 0 {
+		size = min(int(*limit), maxPage)
+	}
+	return saturatingAdd(rpcCost, saturatingMul(size, max(childComplexity, 1)))
+}
+
+func paginationLimit(pagination *model.PaginationInput) *int32 {
+	if pagination == nil {
+		return nil
+	}
+	return &pagination.Limit
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
@@ -0,0 +1,16 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+)
+
+// Admin guards fields marked @admin so only staff requests resolve them.
+func Admin(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	if !auth.IsAdmin(ctx) {
+		return nil, auth.ErrForbidden
+	}
+	return next(ctx)
+}
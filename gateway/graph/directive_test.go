@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+)
+
+const testAdminToken = "staff"
+
+// fakeOrderClient counts the calls that move money.
+type fakeOrderClient struct {
+	orderHandler.GRPCOrderClient
+	calls int
+}
+
+func (f *fakeOrderClient) RefundOrder(ctx context.Context, input *dto.RefundOrder) (*domain.Payment, error) {
+	f.calls++
+	return &domain.Payment{OrderId: input.OrderId, Status: domain.PaymentStatusRefunded}, nil
+}
+
+func (f *fakeOrderClient) CancelOrder(ctx context.Context, orderId string) (*domain.Order, error) {
+	f.calls++
+	return &domain.Order{Id: orderId, Status: domain.OrderStatusCancelled}, nil
+}
+
+func TestMoneyMutationsRequireAdmin(t *testing.T) {
+	orders := &fakeOrderClient{}
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  &Resolver{OrderClient: orders},
+		Directives: DirectiveRoot{Admin: Admin},
+	}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(auth.Middleware(auth.Credentials{AdminToken: testAdminToken})(srv))
+
+	for _, mutation := range []string{
+		`mutation { refundOrder(orderId: "o1") { status } }`,
+		`mutation { cancelOrder(orderId: "o1") { status } }`,
+	} {
+		var resp map[string]any
+		err := c.Post(mutation, &resp)
+		if err == nil || !strings.Contains(err.Error(), auth.ErrForbidden.Error()) {
+			t.Errorf("%s without a token: error = %v, want forbidden", mutation, err)
+		}
+		if orders.calls != 0 {
+			t.Fatalf("%s without a token reached the order service", mutation)
+		}
+
+		if err := c.Post(mutation, &resp, client.AddHeader("Authorization", "Bearer "+testAdminToken)); err != nil {
+			t.Errorf("%s as admin: %v", mutation, err)
+		}
+		if orders.calls != 1 {
+			t.Errorf("%s as admin made %d order service calls, want 1", mutation, orders.calls)
+		}
+		orders.calls = 0
+	}
+}
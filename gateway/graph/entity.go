@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"slices"
+)
+
+// resolveEntities looks up the entities for ids with as few batch calls as
+// the backend allows and returns them in the order of ids, which is the order
+// of the router's representations. Ids that don't exist resolve to nil.
+func resolveEntities[T any](ids []string, batchSize int, fetch func(ids []string) ([]*T, error), idOf func(*T) string) ([]*T, error) {
+	unique := slices.Compact(slices.Sorted(slices.Values(ids)))
+
+	found := make(map[string]*T, len(unique))
+	for batch := range slices.Chunk(unique, batchSize) {
+		entities, err := fetch(batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range entities {
+			found[idOf(entity)] = entity
+		}
+	}
+
+	result := make([]*T, len(ids))
+	for i, id := range ids {
+		result[i] = found[id]
+	}
+	return result, nil
+}
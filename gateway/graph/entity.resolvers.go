@@ -0,0 +1,101 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"log"
+	"time"
+
+	accountDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	catalogDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+)
+
+// FindManyAccountByIDs is the resolver for the findManyAccountByIDs field.
+func (r *entityResolver) FindManyAccountByIDs(ctx context.Context, reps []*model.AccountByIDsInput) ([]*model.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ids := make([]string, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	accounts, err := resolveEntities(ids, 100, func(batch []string) ([]*model.Account, error) {
+		accs, err := r.AccountClient.GetAccounts(ctx, &accountDTO.AccountQuery{Ids: batch})
+		if err != nil {
+			return nil, err
+		}
+		accounts := make([]*model.Account, 0, len(accs))
+		for _, acc := range accs {
+			accounts = append(accounts, &model.Account{ID: acc.Id, Name: acc.Name})
+		}
+		return accounts, nil
+	}, func(account *model.Account) string { return account.ID })
+	if err != nil {
+		log.Printf("Error resolving account entities: %v", err)
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// FindManyCatalogByIDs is the resolver for the findManyCatalogByIDs field.
+func (r *entityResolver) FindManyCatalogByIDs(ctx context.Context, reps []*model.CatalogByIDsInput) ([]*model.Catalog, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ids := make([]string, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	catalogs, err := resolveEntities(ids, 50, func(batch []string) ([]*model.Catalog, error) {
+		cats, err := r.CatalogClient.GetCatalogs(ctx, &catalogDTO.CatalogQuery{Ids: batch})
+		if err != nil {
+			return nil, err
+		}
+		catalogs := make([]*model.Catalog, 0, len(cats))
+		for _, cat := range cats {
+			catalogs = append(catalogs, &model.Catalog{ID: cat.Id, Name: cat.Name, Description: cat.Description, Price: cat.Price})
+		}
+		return catalogs, nil
+	}, func(catalog *model.Catalog) string { return catalog.ID })
+	if err != nil {
+		log.Printf("Error resolving catalog entities: %v", err)
+		return nil, err
+	}
+	return catalogs, nil
+}
+
+// FindManyOrderByIDs is the resolver for the findManyOrderByIDs field.
+func (r *entityResolver) FindManyOrderByIDs(ctx context.Context, reps []*model.OrderByIDsInput) ([]*model.Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ids := make([]string, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	orders, err := resolveEntities(ids, 100, func(batch []string) ([]*model.Order, error) {
+		found, err := r.OrderClient.GetOrdersByIds(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		orders := make([]*model.Order, 0, len(found))
+		for _, o := range found {
+			orders = append(orders, toOrderModel(o))
+		}
+		return orders, nil
+	}, func(order *model.Order) string { return order.ID })
+	if err != nil {
+		log.Printf("Error resolving order entities: %v", err)
+		return nil, err
+	}
+	return orders, nil
+}
+
+// Entity returns EntityResolver implementation.
+func (r *Resolver) Entity() EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/requestid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ErrCodeBadUserInput       = "BAD_USER_INPUT"
+	ErrCodeNotFound           = "NOT_FOUND"
+	ErrCodeAlreadyExists      = "ALREADY_EXISTS"
+	ErrCodeFailedPrecondition = "FAILED_PRECONDITION"
+	ErrCodeForbidden          = "FORBIDDEN"
+	ErrCodeUnauthenticated    = "UNAUTHENTICATED"
+	ErrCodeTimeout            = "TIMEOUT"
+	ErrCodeUnavailable        = "SERVICE_UNAVAILABLE"
+	ErrCodeInternal           = "INTERNAL_SERVER_ERROR"
+)
+
+// grpcErrorCodes lists the status codes whose messages are safe to show to
+// clients. Every other code is reported as an internal error.
+var grpcErrorCodes = map[codes.Code]string{
+	codes.InvalidArgument:    ErrCodeBadUserInput,
+	codes.OutOfRange:         ErrCodeBadUserInput,
+	codes.NotFound:           ErrCodeNotFound,
+	codes.AlreadyExists:      ErrCodeAlreadyExists,
+	codes.FailedPrecondition: ErrCodeFailedPrecondition,
+	codes.PermissionDenied:   ErrCodeForbidden,
+	codes.Unauthenticated:    ErrCodeUnauthenticated,
+	codes.DeadlineExceeded:   ErrCodeTimeout,
+}
+
+// invalidInput reports a resolver-side validation failure on field.
+func invalidInput(field, format string, args ...any) error {
+	err := gqlerror.Errorf(format, args...)
+	errcode.Set(err, ErrCodeBadUserInput)
+	if field != "" {
+		err.Extensions["field"] = field
+	}
+	return err
+}
+
+// ErrorPresenter turns resolver errors into GraphQL errors with a stable
+// extensions.code and the request id. gRPC statuses keep their message and
+// errdetails; anything unexpected is logged and replaced by a generic
+// message so internals never reach the client.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]any{}
+	}
+	requestId := requestid.FromContext(ctx)
+	if requestId != "" {
+		gqlErr.Extensions["requestId"] = requestId
+	}
+
+	// Errors from parsing, validation and the gateway's own extensions
+	// already carry a code and a public message.
+	if _, ok := gqlErr.Extensions["code"]; ok {
+		return gqlErr
+	}
+
+	switch {
+	case errors.Is(err, auth.ErrForbidden), errors.Is(err, auth.ErrNotOwner):
+		gqlErr.Extensions["code"] = ErrCodeForbidden
+		return gqlErr
+	case errors.Is(err, context.DeadlineExceeded):
+		gqlErr.Message = "request timed out"
+		gqlErr.Extensions["code"] = ErrCodeTimeout
+		return gqlErr
+	}
+
+	// Unwrap to the status itself so its message isn't prefixed by the
+	// client's wrapping.
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		st := grpcErr.GRPCStatus()
+		if code, ok := grpcErrorCodes[st.Code()]; ok {
+			gqlErr.Message = st.Message()
+			gqlErr.Extensions["code"] = code
+			addErrorDetails(gqlErr, st)
+			return gqlErr
+		}
+		if st.Code() == codes.Unavailable {
+			log.Printf("Error service unavailable (request %s): %v", requestId, err)
+			gqlErr.Message = "service temporarily unavailable"
+			gqlErr.Extensions["code"] = ErrCodeUnavailable
+			return gqlErr
+		}
+	}
+
+	log.Printf("Error internal (request %s): %v", requestId, err)
+	gqlErr.Message = "internal server error"
+	gqlErr.Extensions["code"] = ErrCodeInternal
+	return gqlErr
+}
+
+// RecoverFunc reports resolver panics as internal errors; the presenter
+// masks the message.
+func RecoverFunc(ctx context.Context, p any) error {
+	log.Printf("Error resolver panic (request %s): %v", requestid.FromContext(ctx), p)
+	return fmt.Errorf("panic: %v", p)
+}
+
+func addErrorDetails(gqlErr *gqlerror.Error, st *status.Status) {
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			gqlErr.Extensions["reason"] = d.GetReason()
+			gqlErr.Extensions["service"] = d.GetDomain()
+		case *errdetails.BadRequest:
+			violations := make([]map[string]string, 0, len(d.GetFieldViolations()))
+			for _, v := range d.GetFieldViolations() {
+				violations = append(violations, map[string]string{"field": v.GetField(), "description": v.GetDescription()})
+				if _, ok := gqlErr.Extensions["field"]; !ok && v.GetField() != "" {
+					gqlErr.Extensions["field"] = v.GetField()
+				}
+			}
+			gqlErr.Extensions["fieldViolations"] = violations
+		case *errdetails.PreconditionFailure:
+			violations := make([]map[string]string, 0, len(d.GetViolations()))
+			for _, v := range d.GetViolations() {
+				violations = append(violations, map[string]string{"type": v.GetType(), "description": v.GetDescription()})
+			}
+			gqlErr.Extensions["preconditionViolations"] = violations
+		}
+	}
+}
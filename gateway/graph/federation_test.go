@@ -0,0 +1,324 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	catalogDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
+	catalogDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// federationPrelude declares the federation v2 directives a subgraph may use
+// so that subgraph SDL can be validated on its own.
+const federationPrelude = `
+scalar FieldSet
+scalar link__Import
+directive @link(url: String!, import: [link__Import]) repeatable on SCHEMA
+directive @key(fields: FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE
+directive @shareable repeatable on FIELD_DEFINITION | OBJECT
+directive @external on OBJECT | FIELD_DEFINITION
+directive @requires(fields: FieldSet!) on FIELD_DEFINITION
+directive @provides(fields: FieldSet!) on FIELD_DEFINITION
+`
+
+var federationDirectives = []string{"link", "key", "shareable", "external", "requires", "provides"}
+
+type fakeAccountClient struct {
+	accountHandler.GRPCAccountClient
+	accounts map[string]*domain.Account
+	calls    int
+}
+
+func (f *fakeAccountClient) GetAccounts(ctx context.Context, input *dto.AccountQuery) ([]*domain.Account, error) {
+	f.calls++
+	var accounts []*domain.Account
+	for _, id := range input.Ids {
+		if account, ok := f.accounts[id]; ok {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts, nil
+}
+
+type fakeCatalogClient struct {
+	catalogHandler.GRPCCatalogClient
+	catalogs map[string]*catalogDomain.Catalog
+	calls    int
+}
+
+func (f *fakeCatalogClient) GetCatalogs(ctx context.Context, input *catalogDTO.CatalogQuery) ([]*catalogDomain.Catalog, error) {
+	f.calls++
+	var catalogs []*catalogDomain.Catalog
+	for _, id := range input.Ids {
+		if catalog, ok := f.catalogs[id]; ok {
+			catalogs = append(catalogs, catalog)
+		}
+	}
+	return catalogs, nil
+}
+
+func newTestClient(resolver *Resolver) *client.Client {
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Admin: Admin},
+		Complexity: NewComplexityRoot(),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	return client.New(srv)
+}
+
+func subgraphSDL(t *testing.T) string {
+	t.Helper()
+	var resp struct {
+		Service struct {
+			SDL string
+		} `json:"_service"`
+	}
+	newTestClient(&Resolver{}).MustPost(`{ _service { sdl } }`, &resp)
+	return resp.Service.SDL
+}
+
+func TestServiceSDLDeclaresEntities(t *testing.T) {
+	sdl := subgraphSDL(t)
+	if !strings.Contains(sdl, `specs.apollo.dev/federation/v2`) {
+		t.Fatalf("sdl does not link the federation v2 spec:\n%s", sdl)
+	}
+
+	doc, err := parser.ParseSchema(&ast.Source{Name: "gateway", Input: sdl})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Account", "Catalog", "Order"} {
+		def := doc.Definitions.ForName(name)
+		if def == nil {
+			t.Fatalf("type %s missing from sdl", name)
+		}
+		key := def.Directives.ForName("key")
+		if key == nil || key.Arguments.ForName("fields").Value.Raw != "id" {
+			t.Errorf("type %s is not an entity keyed by id", name)
+		}
+	}
+}
+
+func TestEntitiesResolveInBatches(t *testing.T) {
+	accounts := &fakeAccountClient{accounts: map[string]*domain.Account{
+		"a1": {Id: "a1", Name: "Ada"},
+	}}
+	catalogs := &fakeCatalogClient{catalogs: map[string]*catalogDomain.Catalog{
+		"c1": {Id: "c1", Name: "Lamp", Price: 10},
+		"c2": {Id: "c2", Name: "Desk", Price: 90},
+	}}
+	c := newTestClient(&Resolver{AccountClient: accounts, CatalogClient: catalogs})
+
+	var resp struct {
+		Entities []*struct {
+			Typename string `json:"__typename"`
+			ID       string
+			Name     string
+		} `json:"_entities"`
+	}
+	c.MustPost(`query($representations: [_Any!]!) {
+  _entities(representations: $representations) {
+    __typename
+    ... on Catalog { id name }
+    ... on Account { id name }
+  }
+}`, &resp, client.Var("representations", []map[string]any{
+		{"__typename": "Catalog", "id": "c2"},
+		{"__typename": "Account", "id": "a1"},
+		{"__typename": "Catalog", "id": "missing"},
+		{"__typename": "Catalog", "id": "c1"},
+		{"__typename": "Catalog", "id": "c2"},
+	}))
+
+	var got []string
+	for _, e := range resp.Entities {
+		if e == nil {
+			got = append(got, "<nil>")
+			continue
+		}
+		got = append(got, e.Typename+":"+e.Name)
+	}
+	want := []string{"Catalog:Desk", "Account:Ada", "<nil>", "Catalog:Lamp", "Catalog:Desk"}
+	if !slices.Equal(got, want) {
+		t.Errorf("entities = %v, want %v", got, want)
+	}
+	if accounts.calls != 1 || catalogs.calls != 1 {
+		t.Errorf("got %d account and %d catalog calls, want one batch each", accounts.calls, catalogs.calls)
+	}
+}
+
+func TestSupergraphComposes(t *testing.T) {
+	reviews, err := os.ReadFile("testdata/reviews.graphqls")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supergraph, err := compose(map[string]string{
+		"gateway": subgraphSDL(t),
+		"reviews": string(reviews),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	catalog := supergraph.Types["Catalog"]
+	for _, field := range []string{"price", "reviews", "averageRating"} {
+		if catalog.Fields.ForName(field) == nil {
+			t.Errorf("supergraph Catalog has no %s field", field)
+		}
+	}
+	if supergraph.Query.Fields.ForName("review") == nil || supergraph.Query.Fields.ForName("products") == nil {
+		t.Error("supergraph Query is missing fields of a subgraph")
+	}
+}
+
+func TestSupergraphRejectsConflictingFields(t *testing.T) {
+	_, err := compose(map[string]string{
+		"gateway": subgraphSDL(t),
+		"pricing": `
+extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key"])
+
+type Catalog @key(fields: "id") {
+  id: String!
+  price: Int!
+}
+`,
+	})
+	if err == nil || !strings.Contains(err.Error(), "Catalog.price") {
+		t.Fatalf("compose error = %v, want a conflict on Catalog.price", err)
+	}
+}
+
+// compose is a local stand-in for supergraph composition. It validates every
+// subgraph against the federation directives, checks the federation v2
+// rules for types and fields that more than one subgraph defines, and
+// validates the merged supergraph schema.
+func compose(subgraphs map[string]string) (*ast.Schema, error) {
+	merged := map[string]*ast.Definition{}
+	owners := map[string]string{}
+	directives := map[string]*ast.DirectiveDefinition{}
+	var order []string
+
+	for _, name := range slices.Sorted(maps.Keys(subgraphs)) {
+		sdl := subgraphs[name]
+		if _, err := gqlparser.LoadSchema(&ast.Source{Name: "federation", Input: federationPrelude}, &ast.Source{Name: name, Input: sdl}); err != nil {
+			return nil, fmt.Errorf("subgraph %s: %w", name, err)
+		}
+		doc, err := parser.ParseSchema(&ast.Source{Name: name, Input: sdl})
+		if err != nil {
+			return nil, fmt.Errorf("subgraph %s: %w", name, err)
+		}
+		for _, d := range doc.Directives {
+			directives[d.Name] = d
+		}
+
+		for _, def := range append(doc.Definitions, doc.Extensions...) {
+			if err := checkKeys(name, def); err != nil {
+				return nil, err
+			}
+			existing, ok := merged[def.Name]
+			if !ok {
+				merged[def.Name] = &ast.Definition{Kind: def.Kind, Name: def.Name, Description: def.Description, Interfaces: def.Interfaces, Types: def.Types, EnumValues: def.EnumValues}
+				order = append(order, def.Name)
+				existing = merged[def.Name]
+			} else if existing.Kind != def.Kind {
+				return nil, fmt.Errorf("type %s is a %s in %s but a %s in %s", def.Name, def.Kind, name, existing.Kind, owners[def.Name])
+			}
+			if _, ok := owners[def.Name]; !ok {
+				owners[def.Name] = name
+			}
+
+			for _, field := range def.Fields {
+				coordinate := def.Name + "." + field.Name
+				prev := existing.Fields.ForName(field.Name)
+				if prev == nil {
+					existing.Fields = append(existing.Fields, field)
+					owners[coordinate] = name
+					continue
+				}
+				if prev.Type.String() != field.Type.String() {
+					return nil, fmt.Errorf("field %s is %s in %s but %s in %s", coordinate, prev.Type, owners[coordinate], field.Type, name)
+				}
+				if !isKeyField(def, field.Name) && !isShareable(def, field) {
+					return nil, fmt.Errorf("field %s is resolved by both %s and %s but is not @shareable", coordinate, owners[coordinate], name)
+				}
+			}
+		}
+	}
+
+	supergraph := &ast.SchemaDocument{}
+	for _, name := range slices.Sorted(maps.Keys(directives)) {
+		if !slices.Contains(federationDirectives, name) {
+			supergraph.Directives = append(supergraph.Directives, directives[name])
+		}
+	}
+	for _, name := range order {
+		def := merged[name]
+		for _, field := range def.Fields {
+			field.Directives = stripFederation(field.Directives)
+		}
+		supergraph.Definitions = append(supergraph.Definitions, def)
+	}
+
+	var sdl strings.Builder
+	formatter.NewFormatter(&sdl).FormatSchemaDocument(supergraph)
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "supergraph", Input: sdl.String()})
+	if err != nil {
+		return nil, fmt.Errorf("supergraph: %w", err)
+	}
+	return schema, nil
+}
+
+// checkKeys makes sure every @key of def names fields that def declares.
+func checkKeys(subgraph string, def *ast.Definition) error {
+	for _, key := range def.Directives.ForNames("key") {
+		for _, field := range strings.Fields(key.Arguments.ForName("fields").Value.Raw) {
+			if def.Fields.ForName(field) == nil {
+				return fmt.Errorf("subgraph %s: @key of %s names unknown field %s", subgraph, def.Name, field)
+			}
+		}
+	}
+	return nil
+}
+
+func isKeyField(def *ast.Definition, field string) bool {
+	for _, key := range def.Directives.ForNames("key") {
+		if slices.Contains(strings.Fields(key.Arguments.ForName("fields").Value.Raw), field) {
+			return true
+		}
+	}
+	return false
+}
+
+func isShareable(def *ast.Definition, field *ast.FieldDefinition) bool {
+	return def.Directives.ForName("shareable") != nil || field.Directives.ForName("shareable") != nil || field.Directives.ForName("external") != nil
+}
+
+func stripFederation(list ast.DirectiveList) ast.DirectiveList {
+	var kept ast.DirectiveList
+	for _, d := range list {
+		if !slices.Contains(federationDirectives, d.Name) {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
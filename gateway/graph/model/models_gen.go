@@ -3,19 +3,82 @@
 package model
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
 type Account struct {
-	ID     string   `json:"id"`
-	Name   string   `json:"name"`
-	Orders []*Order `json:"orders"`
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Orders    []*Order   `json:"orders"`
+	Addresses []*Address `json:"addresses"`
+}
+
+func (Account) IsEntity() {}
+
+type AccountByIDsInput struct {
+	ID string `json:"ID"`
 }
 
 type AccountInput struct {
 	Name string `json:"name"`
 }
 
+type Address struct {
+	ID         string    `json:"id"`
+	AccountID  string    `json:"accountId"`
+	Recipient  string    `json:"recipient"`
+	Line1      string    `json:"line1"`
+	Line2      string    `json:"line2"`
+	City       string    `json:"city"`
+	Region     string    `json:"region"`
+	PostalCode string    `json:"postalCode"`
+	Country    string    `json:"country"`
+	Phone      string    `json:"phone"`
+	IsDefault  bool      `json:"isDefault"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type AddressInput struct {
+	AccountID  string  `json:"accountId"`
+	Recipient  string  `json:"recipient"`
+	Line1      string  `json:"line1"`
+	Line2      *string `json:"line2,omitempty"`
+	City       string  `json:"city"`
+	Region     *string `json:"region,omitempty"`
+	PostalCode string  `json:"postalCode"`
+	Country    string  `json:"country"`
+	Phone      *string `json:"phone,omitempty"`
+	IsDefault  *bool   `json:"isDefault,omitempty"`
+}
+
+type Cart struct {
+	ID         string      `json:"id"`
+	AccountID  *string     `json:"accountId,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+	TotalPrice float64     `json:"totalPrice"`
+	Items      []*CartItem `json:"items"`
+}
+
+type CartItem struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Quantity    int32   `json:"quantity"`
+}
+
+type CartItemInput struct {
+	CartID    string `json:"cartId"`
+	ProductID string `json:"productId"`
+	Quantity  int32  `json:"quantity"`
+}
+
 type Catalog struct {
 	ID          string  `json:"id"`
 	Name        string  `json:"name"`
@@ -23,26 +86,78 @@ type Catalog struct {
 	Price       float64 `json:"price"`
 }
 
+func (Catalog) IsEntity() {}
+
+type CatalogByIDsInput struct {
+	ID string `json:"ID"`
+}
+
 type CatalogInput struct {
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
 	Price       float64 `json:"price"`
 }
 
+type Invoice struct {
+	ID          string    `json:"id"`
+	Number      string    `json:"number"`
+	IssuedAt    time.Time `json:"issuedAt"`
+	Subtotal    float64   `json:"subtotal"`
+	TaxRate     float64   `json:"taxRate"`
+	Tax         float64   `json:"tax"`
+	Total       float64   `json:"total"`
+	DownloadURL string    `json:"downloadUrl"`
+}
+
 type Mutation struct {
 }
 
 type Order struct {
-	ID         string            `json:"id"`
-	AccountID  string            `json:"accountId"`
-	CreatedAt  time.Time         `json:"createdAt"`
-	TotalPrice float64           `json:"totalPrice"`
-	Products   []*OrderedProduct `json:"products"`
+	ID              string            `json:"id"`
+	AccountID       string            `json:"accountId"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	TotalPrice      float64           `json:"totalPrice"`
+	Status          string            `json:"status"`
+	Products        []*OrderedProduct `json:"products"`
+	ShippingAddress *ShippingAddress  `json:"shippingAddress,omitempty"`
+	Returns         []*OrderReturn    `json:"returns"`
+	Shipments       []*Shipment       `json:"shipments"`
+	Invoice         *Invoice          `json:"invoice,omitempty"`
+	Cursor          string            `json:"cursor"`
+}
+
+func (Order) IsEntity() {}
+
+type OrderByIDsInput struct {
+	ID string `json:"ID"`
+}
+
+type OrderFilterInput struct {
+	CreatedFrom *time.Time `json:"createdFrom,omitempty"`
+	CreatedTo   *time.Time `json:"createdTo,omitempty"`
+	Status      []string   `json:"status,omitempty"`
+	MinTotal    *float64   `json:"minTotal,omitempty"`
+	MaxTotal    *float64   `json:"maxTotal,omitempty"`
 }
 
 type OrderInput struct {
-	AccountID string                 `json:"accountId"`
-	Products  []*OrderedProductInput `json:"products"`
+	AccountID         string                 `json:"accountId"`
+	Products          []*OrderedProductInput `json:"products"`
+	ShippingAddressID *string                `json:"shippingAddressId,omitempty"`
+	ShippingAddress   *ShippingAddressInput  `json:"shippingAddress,omitempty"`
+}
+
+type OrderReturn struct {
+	ID                string    `json:"id"`
+	OrderID           string    `json:"orderId"`
+	ProductID         string    `json:"productId"`
+	Quantity          int32     `json:"quantity"`
+	RestockedQuantity int32     `json:"restockedQuantity"`
+	Reason            string    `json:"reason"`
+	Status            string    `json:"status"`
+	RefundAmount      float64   `json:"refundAmount"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
 }
 
 type OrderedProduct struct {
@@ -63,5 +178,287 @@ type PaginationInput struct {
 	Offset int32 `json:"offset"`
 }
 
+type Payment struct {
+	ID                string    `json:"id"`
+	OrderID           string    `json:"orderId"`
+	Provider          string    `json:"provider"`
+	ProviderReference string    `json:"providerReference"`
+	Amount            float64   `json:"amount"`
+	RefundedAmount    float64   `json:"refundedAmount"`
+	Status            string    `json:"status"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type ProductSales struct {
+	ProductID string  `json:"productId"`
+	Name      string  `json:"name"`
+	Quantity  int32   `json:"quantity"`
+	Revenue   float64 `json:"revenue"`
+}
+
 type Query struct {
 }
+
+type ReturnInput struct {
+	OrderID string             `json:"orderId"`
+	Reason  string             `json:"reason"`
+	Lines   []*ReturnLineInput `json:"lines"`
+}
+
+type ReturnLineInput struct {
+	ProductID string `json:"productId"`
+	Quantity  int32  `json:"quantity"`
+}
+
+type RevenueBucket struct {
+	PeriodStart time.Time `json:"periodStart"`
+	Revenue     float64   `json:"revenue"`
+	OrderCount  int32     `json:"orderCount"`
+}
+
+type SalesReport struct {
+	From               time.Time        `json:"from"`
+	To                 time.Time        `json:"to"`
+	Revenue            float64          `json:"revenue"`
+	OrderCount         int32            `json:"orderCount"`
+	AverageOrderValue  float64          `json:"averageOrderValue"`
+	NewCustomers       int32            `json:"newCustomers"`
+	ReturningCustomers int32            `json:"returningCustomers"`
+	RevenueByPeriod    []*RevenueBucket `json:"revenueByPeriod"`
+	TopProducts        []*ProductSales  `json:"topProducts"`
+}
+
+type Shipment struct {
+	ID             string           `json:"id"`
+	OrderID        string           `json:"orderId"`
+	Carrier        string           `json:"carrier"`
+	TrackingNumber string           `json:"trackingNumber"`
+	Status         string           `json:"status"`
+	Lines          []*ShipmentLine  `json:"lines"`
+	Events         []*ShipmentEvent `json:"events"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+type ShipmentEvent struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	OccurredAt  time.Time `json:"occurredAt"`
+}
+
+type ShipmentInput struct {
+	OrderID        string               `json:"orderId"`
+	Carrier        string               `json:"carrier"`
+	TrackingNumber string               `json:"trackingNumber"`
+	Lines          []*ShipmentLineInput `json:"lines"`
+}
+
+type ShipmentLine struct {
+	ProductID string `json:"productId"`
+	Quantity  int32  `json:"quantity"`
+}
+
+type ShipmentLineInput struct {
+	ProductID string `json:"productId"`
+	Quantity  int32  `json:"quantity"`
+}
+
+type ShipmentStatusInput struct {
+	ShipmentID  string  `json:"shipmentId"`
+	Status      string  `json:"status"`
+	Description *string `json:"description,omitempty"`
+	Location    *string `json:"location,omitempty"`
+}
+
+type ShippingAddress struct {
+	Recipient  string `json:"recipient"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
+}
+
+type ShippingAddressInput struct {
+	Recipient  string  `json:"recipient"`
+	Line1      string  `json:"line1"`
+	Line2      *string `json:"line2,omitempty"`
+	City       string  `json:"city"`
+	Region     *string `json:"region,omitempty"`
+	PostalCode string  `json:"postalCode"`
+	Country    string  `json:"country"`
+	Phone      *string `json:"phone,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type ProductRanking string
+
+const (
+	ProductRankingQuantity ProductRanking = "QUANTITY"
+	ProductRankingRevenue  ProductRanking = "REVENUE"
+)
+
+var AllProductRanking = []ProductRanking{
+	ProductRankingQuantity,
+	ProductRankingRevenue,
+}
+
+func (e ProductRanking) IsValid() bool {
+	switch e {
+	case ProductRankingQuantity, ProductRankingRevenue:
+		return true
+	}
+	return false
+}
+
+func (e ProductRanking) String() string {
+	return string(e)
+}
+
+func (e *ProductRanking) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProductRanking(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProductRanking", str)
+	}
+	return nil
+}
+
+func (e ProductRanking) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ProductRanking) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ProductRanking) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportGranularity string
+
+const (
+	ReportGranularityDay   ReportGranularity = "DAY"
+	ReportGranularityWeek  ReportGranularity = "WEEK"
+	ReportGranularityMonth ReportGranularity = "MONTH"
+)
+
+var AllReportGranularity = []ReportGranularity{
+	ReportGranularityDay,
+	ReportGranularityWeek,
+	ReportGranularityMonth,
+}
+
+func (e ReportGranularity) IsValid() bool {
+	switch e {
+	case ReportGranularityDay, ReportGranularityWeek, ReportGranularityMonth:
+		return true
+	}
+	return false
+}
+
+func (e ReportGranularity) String() string {
+	return string(e)
+}
+
+func (e *ReportGranularity) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportGranularity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportGranularity", str)
+	}
+	return nil
+}
+
+func (e ReportGranularity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportGranularity) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportGranularity) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
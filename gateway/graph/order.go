@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"context"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"net/url"
+	"strings"
+)
+
+// authorizeAccount checks that ctx may follow the orders of accountID: it
+// is admin, or signed in as the account.
+func authorizeAccount(ctx context.Context, accountID string) error {
+	if !auth.Owns(ctx, accountID) {
+		return auth.ErrNotOwner
+	}
+	return nil
+}
+
+// orderWatch is the WatchOrders filter for following orderID as ctx. A
+// customer's watch is also scoped to their account, so the order service
+// streams nothing for orders of other accounts.
+func orderWatch(ctx context.Context, orderID string) (*orderDTO.WatchOrders, error) {
+	if auth.IsAdmin(ctx) {
+		return &orderDTO.WatchOrders{OrderId: orderID}, nil
+	}
+	accountID := auth.AccountID(ctx)
+	if accountID == "" {
+		return nil, auth.ErrUnauthenticated
+	}
+	return &orderDTO.WatchOrders{OrderId: orderID, AccountId: accountID}, nil
+}
+
+// toOrderQuery maps the arguments of the orders fields onto an order history
+// query. Paging continues after the cursor of the last order of a page.
+func toOrderQuery(accountID string, filter *model.OrderFilterInput, sort *model.SortDirection, first *int32, after *string) (*orderDTO.OrderQuery, error) {
+	query := &orderDTO.OrderQuery{AccountId: accountID}
+	if filter != nil {
+		if filter.CreatedFrom != nil {
+			query.CreatedFrom = *filter.CreatedFrom
+		}
+		if filter.CreatedTo != nil {
+			query.CreatedTo = *filter.CreatedTo
+		}
+		query.Statuses = filter.Status
+		query.MinTotal = filter.MinTotal
+		query.MaxTotal = filter.MaxTotal
+	}
+	if sort != nil {
+		query.Sort = strings.ToLower(sort.String())
+	}
+	if first != nil {
+		if *first <= 0 {
+			return nil, invalidInput("first", "first must be greater than zero")
+		}
+		query.Limit = uint64(*first)
+	}
+	if after != nil {
+		query.Cursor = *after
+	}
+	return query, nil
+}
+
+func toOrderModel(order *orderDomain.Order) *model.Order {
+	products := make([]*model.OrderedProduct, 0, len(order.Catalogs))
+	for _, c := range order.Catalogs {
+		products = append(products, &model.OrderedProduct{
+			ID:          c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.Price,
+			Quantity:    int32(c.Quantity),
+		})
+	}
+	return &model.Order{
+		ID:              order.Id,
+		AccountID:       order.AccountId,
+		CreatedAt:       order.CreatedAt,
+		TotalPrice:      order.TotalPrice,
+		Status:          order.Status,
+		ShippingAddress: toShippingAddressModel(order.ShippingAddress),
+		Products:        products,
+	}
+}
+
+func toShippingAddressModel(address *orderDomain.ShippingAddress) *model.ShippingAddress {
+	if address == nil {
+		return nil
+	}
+	return &model.ShippingAddress{
+		Recipient:  address.Recipient,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+		Phone:      address.Phone,
+	}
+}
+
+func toShipmentModel(shipment *orderDomain.Shipment) *model.Shipment {
+	lines := make([]*model.ShipmentLine, 0, len(shipment.Lines))
+	for _, l := range shipment.Lines {
+		lines = append(lines, &model.ShipmentLine{
+			ProductID: l.CatalogId,
+			Quantity:  int32(l.Quantity),
+		})
+	}
+
+	events := make([]*model.ShipmentEvent, 0, len(shipment.Events))
+	for _, e := range shipment.Events {
+		events = append(events, &model.ShipmentEvent{
+			Status:      e.Status,
+			Description: e.Description,
+			Location:    e.Location,
+			OccurredAt:  e.OccurredAt,
+		})
+	}
+
+	return &model.Shipment{
+		ID:             shipment.Id,
+		OrderID:        shipment.OrderId,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         shipment.Status,
+		Lines:          lines,
+		Events:         events,
+		CreatedAt:      shipment.CreatedAt,
+		UpdatedAt:      shipment.UpdatedAt,
+	}
+}
+
+func toInvoiceModel(invoice *orderDomain.Invoice) *model.Invoice {
+	return &model.Invoice{
+		ID:          invoice.Id,
+		Number:      invoice.Number,
+		IssuedAt:    invoice.IssuedAt,
+		Subtotal:    invoice.Subtotal,
+		TaxRate:     invoice.TaxRate,
+		Tax:         invoice.Tax,
+		Total:       invoice.Total,
+		DownloadURL: "/invoices/" + url.PathEscape(invoice.Id),
+	}
+}
+
+// toOrderModelStream converts order events for a subscription. The returned
+// channel closes when the event stream does, which ends the subscription.
+func toOrderModelStream(ctx context.Context, events <-chan *orderDomain.OrderEvent) <-chan *model.Order {
+	orders := make(chan *model.Order)
+	go func() {
+		defer close(orders)
+		for event := range events {
+			select {
+			case orders <- toOrderModel(event.Order):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return orders
+}
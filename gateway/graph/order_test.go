@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+)
+
+// watchedOrderClient records the filters of the watches it starts.
+type watchedOrderClient struct {
+	orderHandler.GRPCOrderClient
+	watches []dto.WatchOrders
+}
+
+func (w *watchedOrderClient) WatchOrders(ctx context.Context, input *dto.WatchOrders) (<-chan *domain.OrderEvent, error) {
+	w.watches = append(w.watches, *input)
+	events := make(chan *domain.OrderEvent)
+	close(events)
+	return events, nil
+}
+
+func TestSubscriptionsFollowOwnOrders(t *testing.T) {
+	credentials := auth.Credentials{AdminToken: "staff", AccountSecret: "secret"}
+	signIn := func(token string) context.Context {
+		ctx, err := auth.SignIn(context.Background(), "Bearer "+token, credentials)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ctx
+	}
+
+	for _, tt := range []struct {
+		name        string
+		ctx         context.Context
+		wantErr     error
+		wantWatches []dto.WatchOrders
+	}{
+		{"owner", signIn(auth.AccountToken("secret", "alice")), nil, []dto.WatchOrders{{AccountId: "alice"}, {OrderId: "o1", AccountId: "alice"}}},
+		{"admin", signIn("staff"), nil, []dto.WatchOrders{{AccountId: "alice"}, {OrderId: "o1"}}},
+		// bob may follow o1 only as far as it is his: the watch is scoped
+		// to his account.
+		{"other account", signIn(auth.AccountToken("secret", "bob")), auth.ErrNotOwner, []dto.WatchOrders{{OrderId: "o1", AccountId: "bob"}}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			orders := &watchedOrderClient{}
+			r := &subscriptionResolver{&Resolver{OrderClient: orders}}
+
+			if _, err := r.AccountOrders(tt.ctx, "alice"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("AccountOrders error = %v, want %v", err, tt.wantErr)
+			}
+			if _, err := r.OrderUpdated(tt.ctx, "o1"); err != nil {
+				t.Errorf("OrderUpdated error = %v", err)
+			}
+			if len(orders.watches) != len(tt.wantWatches) {
+				t.Fatalf("watches = %+v, want %+v", orders.watches, tt.wantWatches)
+			}
+			for i, watch := range orders.watches {
+				if watch != tt.wantWatches[i] {
+					t.Errorf("watch %d = %+v, want %+v", i, watch, tt.wantWatches[i])
+				}
+			}
+		})
+	}
+}
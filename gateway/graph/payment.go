@@ -0,0 +1,19 @@
+package graph
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+)
+
+func toPaymentModel(payment *orderDomain.Payment) *model.Payment {
+	return &model.Payment{
+		ID:                payment.Id,
+		OrderID:           payment.OrderId,
+		Provider:          payment.Provider,
+		ProviderReference: payment.ProviderReference,
+		Amount:            payment.Amount,
+		RefundedAmount:    payment.RefundedAmount,
+		Status:            payment.Status,
+		CreatedAt:         payment.CreatedAt,
+	}
+}
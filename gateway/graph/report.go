@@ -0,0 +1,38 @@
+package graph
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"strings"
+	"time"
+)
+
+func toSalesReportQuery(from, to time.Time, granularity *model.ReportGranularity, top *int32, rankBy *model.ProductRanking) (*orderDTO.SalesReportQuery, error) {
+	query := &orderDTO.SalesReportQuery{From: from, To: to}
+	if granularity != nil {
+		query.Granularity = strings.ToLower(granularity.String())
+	}
+	if top != nil {
+		if *top <= 0 {
+			return nil, invalidInput("top", "top must be greater than zero")
+		}
+		query.Limit = uint64(*top)
+	}
+	if rankBy != nil {
+		query.RankBy = strings.ToLower(rankBy.String())
+	}
+	return query, nil
+}
+
+func toRevenueBucketModels(buckets []*orderDomain.RevenueBucket) []*model.RevenueBucket {
+	result := make([]*model.RevenueBucket, 0, len(buckets))
+	for _, b := range buckets {
+		result = append(result, &model.RevenueBucket{
+			PeriodStart: b.PeriodStart,
+			Revenue:     b.Revenue,
+			OrderCount:  int32(b.OrderCount),
+		})
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+)
+
+func toOrderReturnModel(ret *orderDomain.OrderReturn) *model.OrderReturn {
+	return &model.OrderReturn{
+		ID:                ret.Id,
+		OrderID:           ret.OrderId,
+		ProductID:         ret.CatalogId,
+		Quantity:          int32(ret.Quantity),
+		RestockedQuantity: int32(ret.RestockedQuantity),
+		Reason:            ret.Reason,
+		Status:            ret.Status,
+		RefundAmount:      ret.RefundAmount,
+		CreatedAt:         ret.CreatedAt,
+		UpdatedAt:         ret.UpdatedAt,
+	}
+}
+
+func toOrderReturnModels(returns []*orderDomain.OrderReturn) []*model.OrderReturn {
+	result := make([]*model.OrderReturn, 0, len(returns))
+	for _, r := range returns {
+		result = append(result, toOrderReturnModel(r))
+	}
+	return result
+}
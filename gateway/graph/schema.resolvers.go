@@ -11,16 +11,24 @@ import (
 	"time"
 
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	cartDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/cart/domain"
+	cartDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/cart/dto"
 	catalogDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph/model"
 	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
 )
 
-func (r *accountResolver) Orders(ctx context.Context, obj *model.Account) ([]*model.Order, error) {
+// Orders is the resolver for the orders field.
+func (r *accountResolver) Orders(ctx context.Context, obj *model.Account, filter *model.OrderFilterInput, sort *model.SortDirection, first *int32, after *string) ([]*model.Order, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	orders, err := r.OrderClient.GetOrdersForAccount(ctx, obj.ID)
+	query, err := toOrderQuery(obj.ID, filter, sort, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, _, err := r.OrderClient.GetOrdersForAccount(ctx, query)
 	if err != nil {
 		log.Printf("Error fetching orders for account %s: %v", obj.ID, err)
 		return nil, err
@@ -40,17 +48,37 @@ func (r *accountResolver) Orders(ctx context.Context, obj *model.Account) ([]*mo
 			})
 		}
 		result = append(result, &model.Order{
-			ID:         o.Id,
-			AccountID:  o.AccountId,
-			CreatedAt:  o.CreatedAt,
-			TotalPrice: o.TotalPrice,
-			Products:   products,
+			ID:              o.Id,
+			AccountID:       o.AccountId,
+			CreatedAt:       o.CreatedAt,
+			TotalPrice:      o.TotalPrice,
+			Status:          o.Status,
+			ShippingAddress: toShippingAddressModel(o.ShippingAddress),
+			Products:        products,
 		})
 	}
 
 	return result, nil
 }
 
+// Addresses is the resolver for the addresses field.
+func (r *accountResolver) Addresses(ctx context.Context, obj *model.Account) ([]*model.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	addresses, err := r.AccountClient.GetAddressesForAccount(ctx, obj.ID)
+	if err != nil {
+		log.Printf("Error fetching addresses for account %s: %v", obj.ID, err)
+		return nil, err
+	}
+
+	result := make([]*model.Address, 0, len(addresses))
+	for _, a := range addresses {
+		result = append(result, toAddressModel(a))
+	}
+	return result, nil
+}
+
 // CreateAccount is the resolver for the createAccount field.
 func (r *mutationResolver) CreateAccount(ctx context.Context, account model.AccountInput) (*model.Account, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
@@ -96,10 +124,10 @@ func (r *mutationResolver) CreateOrder(ctx context.Context, order model.OrderInp
 	defer cancel()
 
 	if order.AccountID == "" {
-		return nil, fmt.Errorf("account ID is required")
+		return nil, invalidInput("order.accountId", "account ID is required")
 	}
 	if len(order.Products) == 0 {
-		return nil, fmt.Errorf("order must contain at least one product")
+		return nil, invalidInput("order.products", "order must contain at least one product")
 	}
 
 	// Convert GraphQL products to OrderDTO OrderedCatalogs
@@ -107,7 +135,7 @@ func (r *mutationResolver) CreateOrder(ctx context.Context, order model.OrderInp
 	var catalogIDs []string
 	for _, p := range order.Products {
 		if p.Quantity <= 0 {
-			return nil, fmt.Errorf("quantity for product %s must be greater than zero", p.ID)
+			return nil, invalidInput("order.products.quantity", "quantity for product %s must be greater than zero", p.ID)
 		}
 		orderedCatalogs = append(orderedCatalogs, &orderDTO.OrderedCatalog{
 			Id:       p.ID,
@@ -117,10 +145,27 @@ func (r *mutationResolver) CreateOrder(ctx context.Context, order model.OrderInp
 	}
 
 	// Send CreateOrder gRPC request
-	o, err := r.OrderClient.CreateOrder(ctx, &orderDTO.Order{
+	input := &orderDTO.Order{
 		AccountId: order.AccountID,
 		Catalogs:  orderedCatalogs,
-	})
+	}
+	if order.ShippingAddressID != nil {
+		input.ShippingAddressId = *order.ShippingAddressID
+	}
+	if a := order.ShippingAddress; a != nil {
+		input.ShippingAddress = &orderDTO.ShippingAddress{
+			Recipient:  a.Recipient,
+			Line1:      a.Line1,
+			Line2:      deref(a.Line2),
+			City:       a.City,
+			Region:     deref(a.Region),
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      deref(a.Phone),
+		}
+	}
+
+	o, err := r.OrderClient.CreateOrder(ctx, input)
 	if err != nil {
 		log.Printf("Error creating order: %v", err)
 		return nil, err
@@ -153,13 +198,412 @@ func (r *mutationResolver) CreateOrder(ctx context.Context, order model.OrderInp
 	}
 
 	return &model.Order{
-		ID:         o.Id,
-		TotalPrice: o.TotalPrice,
-		CreatedAt:  o.CreatedAt,
-		Products:   products,
+		ID:              o.Id,
+		TotalPrice:      o.TotalPrice,
+		Status:          o.Status,
+		ShippingAddress: toShippingAddressModel(o.ShippingAddress),
+		CreatedAt:       o.CreatedAt,
+		Products:        products,
 	}, nil
 }
 
+// CreateCart is the resolver for the createCart field.
+func (r *mutationResolver) CreateCart(ctx context.Context, accountID *string) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	id := ""
+	if accountID != nil {
+		id = *accountID
+	}
+
+	cart, err := r.CartClient.CreateCart(ctx, id)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return toCartModel(cart), nil
+}
+
+// AddCartItem is the resolver for the addCartItem field.
+func (r *mutationResolver) AddCartItem(ctx context.Context, item model.CartItemInput) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	if item.Quantity <= 0 {
+		return nil, invalidInput("item.quantity", "quantity for product %s must be greater than zero", item.ProductID)
+	}
+
+	cart, err := r.CartClient.AddCartItem(ctx, &cartDTO.CartItem{
+		CartId:    item.CartID,
+		CatalogId: item.ProductID,
+		Quantity:  uint32(item.Quantity),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return toCartModel(cart), nil
+}
+
+// UpdateCartItem is the resolver for the updateCartItem field.
+func (r *mutationResolver) UpdateCartItem(ctx context.Context, item model.CartItemInput) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	if item.Quantity < 0 {
+		return nil, invalidInput("item.quantity", "quantity for product %s must not be negative", item.ProductID)
+	}
+
+	cart, err := r.CartClient.UpdateCartItem(ctx, &cartDTO.CartItem{
+		CartId:    item.CartID,
+		CatalogId: item.ProductID,
+		Quantity:  uint32(item.Quantity),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return toCartModel(cart), nil
+}
+
+// RemoveCartItem is the resolver for the removeCartItem field.
+func (r *mutationResolver) RemoveCartItem(ctx context.Context, cartID string, productID string) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	cart, err := r.CartClient.RemoveCartItem(ctx, cartID, productID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return toCartModel(cart), nil
+}
+
+// MergeCart is the resolver for the mergeCart field.
+func (r *mutationResolver) MergeCart(ctx context.Context, guestCartID string, accountID string) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cart, err := r.CartClient.MergeCarts(ctx, &cartDTO.MergeCarts{
+		GuestCartId: guestCartID,
+		AccountId:   accountID,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return toCartModel(cart), nil
+}
+
+// RefreshCart is the resolver for the refreshCart field.
+func (r *mutationResolver) RefreshCart(ctx context.Context, cartID string) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cart, err := r.CartClient.RefreshCart(ctx, cartID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return toCartModel(cart), nil
+}
+
+// CheckoutCart is the resolver for the checkoutCart field.
+func (r *mutationResolver) CheckoutCart(ctx context.Context, cartID string, shippingAddressID *string) (*model.Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	addressID := ""
+	if shippingAddressID != nil {
+		addressID = *shippingAddressID
+	}
+
+	o, err := r.CartClient.CheckoutCart(ctx, cartID, addressID)
+	if err != nil {
+		log.Printf("Error checking out cart %s: %v", cartID, err)
+		return nil, err
+	}
+
+	var products []*model.OrderedProduct
+	for _, c := range o.Catalogs {
+		products = append(products, &model.OrderedProduct{
+			ID:          c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.Price,
+			Quantity:    int32(c.Quantity),
+		})
+	}
+
+	return &model.Order{
+		ID:              o.Id,
+		AccountID:       o.AccountId,
+		CreatedAt:       o.CreatedAt,
+		TotalPrice:      o.TotalPrice,
+		Status:          o.Status,
+		ShippingAddress: toShippingAddressModel(o.ShippingAddress),
+		Products:        products,
+	}, nil
+}
+
+// PayOrder is the resolver for the payOrder field.
+func (r *mutationResolver) PayOrder(ctx context.Context, orderID string, paymentMethod string) (*model.Payment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	p, err := r.OrderClient.PayOrder(ctx, &orderDTO.PayOrder{
+		OrderId:       orderID,
+		PaymentMethod: paymentMethod,
+	})
+	if err != nil {
+		log.Printf("Error paying order %s: %v", orderID, err)
+		return nil, err
+	}
+	return toPaymentModel(p), nil
+}
+
+// RefundOrder is the resolver for the refundOrder field.
+func (r *mutationResolver) RefundOrder(ctx context.Context, orderID string, amount *float64) (*model.Payment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	refund := 0.0
+	if amount != nil {
+		if *amount <= 0 {
+			return nil, invalidInput("amount", "refund amount must be greater than zero")
+		}
+		refund = *amount
+	}
+
+	p, err := r.OrderClient.RefundOrder(ctx, &orderDTO.RefundOrder{
+		OrderId: orderID,
+		Amount:  refund,
+	})
+	if err != nil {
+		log.Printf("Error refunding order %s: %v", orderID, err)
+		return nil, err
+	}
+	return toPaymentModel(p), nil
+}
+
+// CancelOrder is the resolver for the cancelOrder field.
+func (r *mutationResolver) CancelOrder(ctx context.Context, orderID string) (*model.Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	o, err := r.OrderClient.CancelOrder(ctx, orderID)
+	if err != nil {
+		log.Printf("Error cancelling order %s: %v", orderID, err)
+		return nil, err
+	}
+	return toOrderModel(o), nil
+}
+
+// RequestReturn is the resolver for the requestReturn field.
+func (r *mutationResolver) RequestReturn(ctx context.Context, input model.ReturnInput) ([]*model.OrderReturn, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	lines := make([]*orderDTO.ReturnLine, 0, len(input.Lines))
+	for _, l := range input.Lines {
+		if l.Quantity <= 0 {
+			return nil, invalidInput("input.lines.quantity", "return quantity must be greater than zero")
+		}
+		lines = append(lines, &orderDTO.ReturnLine{
+			CatalogId: l.ProductID,
+			Quantity:  uint32(l.Quantity),
+		})
+	}
+
+	returns, err := r.OrderClient.RequestReturn(ctx, &orderDTO.RequestReturn{
+		OrderId: input.OrderID,
+		Reason:  input.Reason,
+		Lines:   lines,
+	})
+	if err != nil {
+		log.Printf("Error requesting return for order %s: %v", input.OrderID, err)
+		return nil, err
+	}
+	return toOrderReturnModels(returns), nil
+}
+
+// ApproveReturn is the resolver for the approveReturn field.
+func (r *mutationResolver) ApproveReturn(ctx context.Context, id string) (*model.OrderReturn, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ret, err := r.OrderClient.ApproveReturn(ctx, id)
+	if err != nil {
+		log.Printf("Error approving return %s: %v", id, err)
+		return nil, err
+	}
+	return toOrderReturnModel(ret), nil
+}
+
+// RejectReturn is the resolver for the rejectReturn field.
+func (r *mutationResolver) RejectReturn(ctx context.Context, id string) (*model.OrderReturn, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ret, err := r.OrderClient.RejectReturn(ctx, id)
+	if err != nil {
+		log.Printf("Error rejecting return %s: %v", id, err)
+		return nil, err
+	}
+	return toOrderReturnModel(ret), nil
+}
+
+// ReceiveReturn is the resolver for the receiveReturn field.
+func (r *mutationResolver) ReceiveReturn(ctx context.Context, id string) (*model.OrderReturn, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ret, err := r.OrderClient.ReceiveReturn(ctx, id)
+	if err != nil {
+		log.Printf("Error receiving return %s: %v", id, err)
+		return nil, err
+	}
+	return toOrderReturnModel(ret), nil
+}
+
+// CreateAddress is the resolver for the createAddress field.
+func (r *mutationResolver) CreateAddress(ctx context.Context, address model.AddressInput) (*model.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	a, err := r.AccountClient.CreateAddress(ctx, toAddressDTO("", address))
+	if err != nil {
+		log.Printf("Error creating address for account %s: %v", address.AccountID, err)
+		return nil, err
+	}
+	return toAddressModel(a), nil
+}
+
+// UpdateAddress is the resolver for the updateAddress field.
+func (r *mutationResolver) UpdateAddress(ctx context.Context, id string, address model.AddressInput) (*model.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	a, err := r.AccountClient.UpdateAddress(ctx, toAddressDTO(id, address))
+	if err != nil {
+		log.Printf("Error updating address %s: %v", id, err)
+		return nil, err
+	}
+	return toAddressModel(a), nil
+}
+
+// DeleteAddress is the resolver for the deleteAddress field.
+func (r *mutationResolver) DeleteAddress(ctx context.Context, id string, accountID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := r.AccountClient.DeleteAddress(ctx, id, accountID); err != nil {
+		log.Printf("Error deleting address %s: %v", id, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateShipment is the resolver for the createShipment field.
+func (r *mutationResolver) CreateShipment(ctx context.Context, shipment model.ShipmentInput) (*model.Shipment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	lines := make([]*orderDTO.ShipmentLine, 0, len(shipment.Lines))
+	for _, l := range shipment.Lines {
+		if l.Quantity <= 0 {
+			return nil, invalidInput("shipment.lines.quantity", "shipment quantity must be greater than zero")
+		}
+		lines = append(lines, &orderDTO.ShipmentLine{
+			CatalogId: l.ProductID,
+			Quantity:  uint32(l.Quantity),
+		})
+	}
+
+	s, err := r.OrderClient.CreateShipment(ctx, &orderDTO.CreateShipment{
+		OrderId:        shipment.OrderID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Lines:          lines,
+	})
+	if err != nil {
+		log.Printf("Error creating shipment for order %s: %v", shipment.OrderID, err)
+		return nil, err
+	}
+	return toShipmentModel(s), nil
+}
+
+// UpdateShipmentStatus is the resolver for the updateShipmentStatus field.
+func (r *mutationResolver) UpdateShipmentStatus(ctx context.Context, input model.ShipmentStatusInput) (*model.Shipment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	s, err := r.OrderClient.UpdateShipmentStatus(ctx, &orderDTO.UpdateShipmentStatus{
+		ShipmentId:  input.ShipmentID,
+		Status:      input.Status,
+		Description: deref(input.Description),
+		Location:    deref(input.Location),
+	})
+	if err != nil {
+		log.Printf("Error updating shipment %s: %v", input.ShipmentID, err)
+		return nil, err
+	}
+	return toShipmentModel(s), nil
+}
+
+// Returns is the resolver for the returns field.
+func (r *orderResolver) Returns(ctx context.Context, obj *model.Order) ([]*model.OrderReturn, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	returns, err := r.OrderClient.GetReturnsForOrder(ctx, obj.ID)
+	if err != nil {
+		log.Printf("Error fetching returns for order %s: %v", obj.ID, err)
+		return nil, err
+	}
+	return toOrderReturnModels(returns), nil
+}
+
+// Shipments is the resolver for the shipments field.
+func (r *orderResolver) Shipments(ctx context.Context, obj *model.Order) ([]*model.Shipment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	shipments, err := r.OrderClient.GetShipmentsForOrder(ctx, obj.ID)
+	if err != nil {
+		log.Printf("Error fetching shipments for order %s: %v", obj.ID, err)
+		return nil, err
+	}
+
+	result := make([]*model.Shipment, 0, len(shipments))
+	for _, s := range shipments {
+		result = append(result, toShipmentModel(s))
+	}
+	return result, nil
+}
+
+// Invoice is the resolver for the invoice field.
+func (r *orderResolver) Invoice(ctx context.Context, obj *model.Order) (*model.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	invoice, err := r.OrderClient.GetInvoiceForOrder(ctx, obj.ID)
+	if err != nil {
+		log.Printf("Error fetching invoice for order %s: %v", obj.ID, err)
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, nil
+	}
+	return toInvoiceModel(invoice), nil
+}
+
+// Cursor is the resolver for the cursor field.
+func (r *orderResolver) Cursor(ctx context.Context, obj *model.Order) (string, error) {
+	return orderDTO.EncodeOrderCursor(obj.CreatedAt, obj.ID), nil
+}
+
 // Accounts is the resolver for the accounts field.
 func (r *queryResolver) Accounts(ctx context.Context, pagination *model.PaginationInput, id *string) ([]*model.Account, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
@@ -261,66 +705,153 @@ func (r *queryResolver) Products(ctx context.Context, pagination *model.Paginati
 }
 
 // Orders is the resolver for the orders field.
-func (r *queryResolver) Orders(ctx context.Context, order model.OrderInput) ([]*model.Order, error) {
+func (r *queryResolver) Orders(ctx context.Context, order model.OrderInput, filter *model.OrderFilterInput, sort *model.SortDirection, first *int32, after *string) ([]*model.Order, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Fetch orders for the account
-	orders, err := r.OrderClient.GetOrdersForAccount(ctx, order.AccountID)
+	query, err := toOrderQuery(order.AccountID, filter, sort, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, _, err := r.OrderClient.GetOrdersForAccount(ctx, query)
 	if err != nil {
 		log.Printf("Error fetching orders for account %s: %v", order.AccountID, err)
 		return nil, err
 	}
 
-	// Collect catalog IDs
-	catalogIdMap := make(map[string]bool)
+	// The order service fills in the products' names and prices.
+	result := make([]*model.Order, 0, len(orders))
 	for _, o := range orders {
-		for _, c := range o.Catalogs {
-			catalogIdMap[c.Id] = true
-		}
+		result = append(result, toOrderModel(o))
 	}
-	var catalogIDs []string
-	for id := range catalogIdMap {
-		catalogIDs = append(catalogIDs, id)
+	return result, nil
+}
+
+// Cart is the resolver for the cart field.
+func (r *queryResolver) Cart(ctx context.Context, id *string, accountID *string) (*model.Cart, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	var cart *cartDomain.Cart
+	var err error
+	switch {
+	case id != nil:
+		cart, err = r.CartClient.GetCart(ctx, *id)
+	case accountID != nil:
+		cart, err = r.CartClient.GetCartForAccount(ctx, *accountID)
+	default:
+		return nil, invalidInput("id", "either id or accountId is required")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, err
 	}
+	return toCartModel(cart), nil
+}
 
-	// Fetch full catalog details
-	catalogs, err := r.CatalogClient.GetCatalogs(ctx, &catalogDTO.CatalogQuery{
-		Ids: catalogIDs,
-	})
+// SalesReport is the resolver for the salesReport field.
+func (r *queryResolver) SalesReport(ctx context.Context, from time.Time, to time.Time, granularity *model.ReportGranularity, top *int32, rankBy *model.ProductRanking) (*model.SalesReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query, err := toSalesReportQuery(from, to, granularity, top, rankBy)
 	if err != nil {
-		log.Printf("Error fetching catalogs for orders: %v", err)
 		return nil, err
 	}
 
-	// Map orders + products
-	var result []*model.Order
-	for _, o := range orders {
-		var products []*model.OrderedProduct
-		for _, c := range o.Catalogs {
-			for _, cat := range catalogs {
-				if cat.Id == c.Id {
-					products = append(products, &model.OrderedProduct{
-						ID:          c.Id,
-						Name:        cat.Name,
-						Description: cat.Description,
-						Price:       cat.Price,
-						Quantity:    int32(c.Quantity),
-					})
-					break
-				}
-			}
+	summary, err := r.ReportingClient.GetSalesSummary(ctx, query)
+	if err != nil {
+		log.Printf("Error fetching sales summary: %v", err)
+		return nil, err
+	}
+
+	customers, err := r.ReportingClient.GetCustomerBreakdown(ctx, query)
+	if err != nil {
+		log.Printf("Error fetching customer breakdown: %v", err)
+		return nil, err
+	}
+
+	buckets, err := r.ReportingClient.GetRevenue(ctx, query)
+	if err != nil {
+		log.Printf("Error fetching revenue report: %v", err)
+		return nil, err
+	}
+
+	products, err := r.ReportingClient.GetTopProducts(ctx, query)
+	if err != nil {
+		log.Printf("Error fetching top products: %v", err)
+		return nil, err
+	}
+
+	catalogIDs := make([]string, 0, len(products))
+	for _, p := range products {
+		catalogIDs = append(catalogIDs, p.CatalogId)
+	}
+
+	names := make(map[string]string, len(catalogIDs))
+	if len(catalogIDs) > 0 {
+		catalogs, err := r.CatalogClient.GetCatalogs(ctx, &catalogDTO.CatalogQuery{Ids: catalogIDs})
+		if err != nil {
+			log.Printf("Error fetching catalogs for sales report: %v", err)
+			return nil, err
 		}
-		result = append(result, &model.Order{
-			ID:         o.Id,
-			AccountID:  o.AccountId,
-			CreatedAt:  o.CreatedAt,
-			TotalPrice: o.TotalPrice,
-			Products:   products,
+		for _, c := range catalogs {
+			names[c.Id] = c.Name
+		}
+	}
+
+	topProducts := make([]*model.ProductSales, 0, len(products))
+	for _, p := range products {
+		topProducts = append(topProducts, &model.ProductSales{
+			ProductID: p.CatalogId,
+			Name:      names[p.CatalogId],
+			Quantity:  int32(p.Quantity),
+			Revenue:   p.Revenue,
 		})
 	}
 
-	return result, nil
+	return &model.SalesReport{
+		From:               from,
+		To:                 to,
+		Revenue:            summary.Revenue,
+		OrderCount:         int32(summary.OrderCount),
+		AverageOrderValue:  summary.AverageOrderValue,
+		NewCustomers:       int32(customers.NewCustomers),
+		ReturningCustomers: int32(customers.ReturningCustomers),
+		RevenueByPeriod:    toRevenueBucketModels(buckets),
+		TopProducts:        topProducts,
+	}, nil
+}
+
+// OrderUpdated is the resolver for the orderUpdated field.
+func (r *subscriptionResolver) OrderUpdated(ctx context.Context, orderID string) (<-chan *model.Order, error) {
+	watch, err := orderWatch(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.OrderClient.WatchOrders(ctx, watch)
+	if err != nil {
+		log.Printf("Error watching order %s: %v", orderID, err)
+		return nil, err
+	}
+	return toOrderModelStream(ctx, events), nil
+}
+
+// AccountOrders is the resolver for the accountOrders field.
+func (r *subscriptionResolver) AccountOrders(ctx context.Context, accountID string) (<-chan *model.Order, error) {
+	if err := authorizeAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	events, err := r.OrderClient.WatchOrders(ctx, &orderDTO.WatchOrders{AccountId: accountID})
+	if err != nil {
+		log.Printf("Error watching orders for account %s: %v", accountID, err)
+		return nil, err
+	}
+	return toOrderModelStream(ctx, events), nil
 }
 
 // Account returns AccountResolver implementation.
@@ -329,9 +860,17 @@ func (r *Resolver) Account() AccountResolver { return &accountResolver{r} }
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// Order returns OrderResolver implementation.
+func (r *Resolver) Order() OrderResolver { return &orderResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type accountResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type orderResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,59 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+)
+
+type downloadHandler struct {
+	orderClient orderHandler.GRPCOrderClient
+}
+
+// ServeHTTP streams a rendered invoice to admin requests and to the account
+// it bills; to other accounts it doesn't exist.
+func (d *downloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !auth.IsAdmin(r.Context()) && auth.AccountID(r.Context()) == "" {
+		http.Error(w, auth.ErrUnauthenticated.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	document, err := d.orderClient.GetInvoice(ctx, &dto.GetInvoice{
+		InvoiceId: r.PathValue("id"),
+		// Scopes a customer to their own invoices; admins see every one.
+		AccountId: auth.AccountID(r.Context()),
+		Format:    r.URL.Query().Get("format"),
+	})
+	if err != nil {
+		log.Printf("Error fetching invoice %s: %v", r.PathValue("id"), err)
+		http.Error(w, "invoice not found", http.StatusNotFound)
+		return
+	}
+
+	extension := "pdf"
+	if r.URL.Query().Get("format") == "html" {
+		extension = "html"
+	}
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(document.Content)))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", document.Invoice.Number+"."+extension))
+	if _, err := w.Write(document.Content); err != nil {
+		log.Printf("Error writing invoice %s: %v", document.Invoice.Id, err)
+	}
+}
+
+func NewDownloadHandler(orderClient orderHandler.GRPCOrderClient) http.Handler {
+	return &downloadHandler{
+		orderClient: orderClient,
+	}
+}
@@ -0,0 +1,61 @@
+package invoice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+)
+
+// fakeOrderClient holds one invoice, billed to alice, and scopes lookups
+// like the order service does.
+type fakeOrderClient struct {
+	orderHandler.GRPCOrderClient
+}
+
+func (f *fakeOrderClient) GetInvoice(ctx context.Context, input *dto.GetInvoice) (*domain.InvoiceDocument, error) {
+	if input.InvoiceId != "inv" || (input.AccountId != "" && input.AccountId != "alice") {
+		return nil, repository.ErrNoRows
+	}
+	return &domain.InvoiceDocument{
+		Invoice:     &domain.Invoice{Id: "inv", AccountId: "alice", Number: "INV-2026-000001"},
+		Content:     []byte("%PDF"),
+		ContentType: "application/pdf",
+	}, nil
+}
+
+func TestDownloadIsForTheBilledAccount(t *testing.T) {
+	credentials := auth.Credentials{AdminToken: "staff", AccountSecret: "secret"}
+	mux := http.NewServeMux()
+	mux.Handle("GET /invoices/{id}", auth.Middleware(credentials)(NewDownloadHandler(&fakeOrderClient{})))
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{"anonymous", "", http.StatusUnauthorized},
+		{"admin", "staff", http.StatusOK},
+		{"billed account", auth.AccountToken("secret", "alice"), http.StatusOK},
+		{"other account", auth.AccountToken("secret", "bob"), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/invoices/inv", nil)
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
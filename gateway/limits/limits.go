@@ -0,0 +1,102 @@
+package limits
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	ErrCodeTooDeep    = "QUERY_TOO_DEEP"
+	ErrCodeTooComplex = "QUERY_TOO_COMPLEX"
+)
+
+// QueryLimits rejects operations nested deeper than MaxDepth or costing more
+// than MaxComplexity before any resolver runs. Costs come from the schema's
+// ComplexityRoot. A limit of zero or less is not enforced.
+type QueryLimits struct {
+	MaxDepth      int
+	MaxComplexity int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &QueryLimits{}
+
+func (q *QueryLimits) ExtensionName() string {
+	return "QueryLimits"
+}
+
+func (q *QueryLimits) Validate(schema graphql.ExecutableSchema) error {
+	if schema == nil {
+		return errors.New("QueryLimits requires an executable schema")
+	}
+	q.es = schema
+	return nil
+}
+
+func (q *QueryLimits) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	if q.MaxDepth > 0 {
+		if depth := selectionDepth(op.SelectionSet); depth > q.MaxDepth {
+			err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, q.MaxDepth)
+			errcode.Set(err, ErrCodeTooDeep)
+			err.Extensions["depth"] = depth
+			err.Extensions["maxDepth"] = q.MaxDepth
+			return err
+		}
+	}
+
+	if q.MaxComplexity > 0 {
+		if cost := complexity.Calculate(ctx, q.es, op, opCtx.Variables); cost > q.MaxComplexity {
+			err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", cost, q.MaxComplexity)
+			errcode.Set(err, ErrCodeTooComplex)
+			err.Extensions["complexity"] = cost
+			err.Extensions["maxComplexity"] = q.MaxComplexity
+			return err
+		}
+	}
+	return nil
+}
+
+// selectionDepth counts nested fields; fragments add no depth of their own.
+// Introspection fields are skipped so schema tooling keeps working.
+func selectionDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		var d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			d = selectionDepth(s.Definition.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(s.SelectionSet)
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
+
+func New(maxDepth, maxComplexity int) *QueryLimits {
+	return &QueryLimits{
+		MaxDepth:      maxDepth,
+		MaxComplexity: maxComplexity,
+	}
+}
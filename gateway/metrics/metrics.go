@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	operations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_operations_total",
+		Help: "GraphQL operations handled, by operation name, type and outcome.",
+	}, []string{"operation", "type", "outcome"})
+	operationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "graphql_operation_duration_seconds",
+		Help:    "Time to produce a GraphQL response, by operation name and type.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation", "type"})
+)
+
+// otherOperation labels the operations whose names aren't known.
+const otherOperation = "other"
+
+// Operations records a count and a latency for every GraphQL response. For
+// subscriptions each event is a response. Operation names come from clients,
+// so only known names, those of the persisted query manifest, become label
+// values; every other operation is counted as "other".
+type Operations struct {
+	known map[string]bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Operations{}
+
+func NewOperations(names []string) Operations {
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+	return Operations{known: known}
+}
+
+func (Operations) ExtensionName() string {
+	return "Metrics"
+}
+
+func (Operations) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (o Operations) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	resp := next(ctx)
+	// A nil response ends a subscription; there is nothing to record.
+	if resp == nil {
+		return nil
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	name, opType := opCtx.OperationName, "unknown"
+	if op := opCtx.Operation; op != nil {
+		name, opType = op.Name, string(op.Operation)
+	}
+	if name == "" {
+		name = "anonymous"
+	} else if !o.known[name] {
+		name = otherOperation
+	}
+	outcome := "success"
+	if len(resp.Errors) > 0 {
+		outcome = "error"
+	}
+
+	operations.WithLabelValues(name, opType, outcome).Inc()
+	operationDuration.WithLabelValues(name, opType).Observe(time.Since(start).Seconds())
+	return resp
+}
@@ -0,0 +1,84 @@
+package persisted
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const ErrCodeNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+var lookups = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "graphql_persisted_queries_total",
+	Help: "Operations looked up in the persisted query manifest, by result: hit, miss (passed through) or rejected.",
+}, []string{"result"})
+
+// Allowlist resolves operations from a manifest. Clients send the operation
+// id in the persistedQuery extension, or the full document whose hash must
+// be listed. With Enforce set anything not in the manifest is rejected;
+// otherwise it passes through unchanged.
+type Allowlist struct {
+	Enforce   bool
+	documents map[string]string
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = &Allowlist{}
+
+func (a *Allowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+func (a *Allowlist) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a *Allowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	var extension struct {
+		Sha256 string `mapstructure:"sha256Hash"`
+	}
+	if rawParams.Extensions["persistedQuery"] != nil {
+		if err := mapstructure.Decode(rawParams.Extensions["persistedQuery"], &extension); err != nil {
+			return gqlerror.Errorf("invalid persisted query extension data")
+		}
+	}
+
+	hash := extension.Sha256
+	if hash == "" && rawParams.Query != "" {
+		hash = Hash(rawParams.Query)
+	}
+
+	document, ok := a.documents[hash]
+	if ok && (rawParams.Query == "" || rawParams.Query == document) {
+		lookups.WithLabelValues("hit").Inc()
+		rawParams.Query = document
+		return nil
+	}
+
+	if !a.Enforce {
+		lookups.WithLabelValues("miss").Inc()
+		return nil
+	}
+	lookups.WithLabelValues("rejected").Inc()
+	err := gqlerror.Errorf("operation is not in the persisted query allowlist")
+	errcode.Set(err, ErrCodeNotAllowed)
+	return err
+}
+
+func NewAllowlist(manifest *Manifest, enforce bool) *Allowlist {
+	documents := make(map[string]string, len(manifest.Operations))
+	for _, op := range manifest.Operations {
+		documents[op.Id] = op.Body
+	}
+	return &Allowlist{
+		Enforce:   enforce,
+		documents: documents,
+	}
+}
@@ -0,0 +1,83 @@
+package persisted
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// The manifest uses Apollo's persisted query manifest format so client
+// tooling can consume the same file.
+const (
+	ManifestFormat  = "apollo-persisted-query-manifest"
+	ManifestVersion = 1
+)
+
+type Operation struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Body string `json:"body"`
+}
+
+type Manifest struct {
+	Format     string       `json:"format"`
+	Version    int          `json:"version"`
+	Operations []*Operation `json:"operations"`
+}
+
+// Hash is the id of an operation: the hex sha256 of its body, as sent by
+// clients in the persistedQuery extension.
+func Hash(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate checks that every operation id is the hash of its body, so a
+// tampered or hand-edited manifest fails at startup.
+func (m *Manifest) Validate() error {
+	if m.Format != ManifestFormat || m.Version != ManifestVersion {
+		return fmt.Errorf("unsupported manifest format %q version %d", m.Format, m.Version)
+	}
+	seen := make(map[string]bool, len(m.Operations))
+	for _, op := range m.Operations {
+		if op.Id != Hash(op.Body) {
+			return fmt.Errorf("operation %q: id does not match the hash of its body", op.Name)
+		}
+		if seen[op.Id] {
+			return fmt.Errorf("operation %q: duplicate id %s", op.Name, op.Id)
+		}
+		seen[op.Id] = true
+	}
+	return nil
+}
+
+// Names returns the names of the manifest's operations. A nil manifest has
+// none.
+func (m *Manifest) Names() []string {
+	if m == nil {
+		return nil
+	}
+	names := make([]string, 0, len(m.Operations))
+	for _, op := range m.Operations {
+		names = append(names, op.Name)
+	}
+	return names
+}
+
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parse manifest %s: %w", path, err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("manifest %s: %w", path, err)
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,31 @@
+package rest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+)
+
+// openapiYAML is generated by protoc-gen-openapi from the same annotations
+// as the REST handlers; see the generate-openapi target in the Makefile.
+//
+//go:embed openapi.yaml
+var openapiYAML []byte
+
+// NewOpenAPIHandler serves the OpenAPI v3 document as JSON.
+func NewOpenAPIHandler() (http.Handler, error) {
+	var document any
+	if err := yaml.Unmarshal(openapiYAML, &document); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}), nil
+}
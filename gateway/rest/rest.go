@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	accountProto "github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/proto"
+	catalogProto "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
+	orderProto "github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/requestid"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminMethods are the RPCs that GraphQL guards with @admin; the REST API
+// applies the same rule.
+var adminMethods = []string{
+	orderProto.OrderService_ApproveReturn_FullMethodName,
+	orderProto.OrderService_RejectReturn_FullMethodName,
+	orderProto.OrderService_ReceiveReturn_FullMethodName,
+	orderProto.OrderService_CreateShipment_FullMethodName,
+	orderProto.OrderService_UpdateShipmentStatus_FullMethodName,
+}
+
+// publicCodes are the status codes whose messages may reach REST clients.
+var publicCodes = []codes.Code{
+	codes.InvalidArgument,
+	codes.OutOfRange,
+	codes.NotFound,
+	codes.AlreadyExists,
+	codes.FailedPrecondition,
+	codes.PermissionDenied,
+	codes.Unauthenticated,
+	codes.DeadlineExceeded,
+}
+
+type Endpoints struct {
+	Account string
+	Catalog string
+	Order   string
+}
+
+// NewHandler serves the HTTP/JSON API transcoded from the google.api.http
+// annotations of the account, catalog and order services. Connections are
+// closed when ctx is done.
+func NewHandler(ctx context.Context, endpoints Endpoints, creds *mtls.Credentials) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(errorHandler))
+	opts := []grpc.DialOption{
+		creds.DialOption(),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), adminInterceptor, postgres.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor()),
+	}
+	opts = append(opts, grpcx.DialOptions()...)
+
+	if err := accountProto.RegisterAccountServiceHandlerFromEndpoint(ctx, mux, endpoints.Account, opts); err != nil {
+		return nil, err
+	}
+	if err := catalogProto.RegisterCatalogServiceHandlerFromEndpoint(ctx, mux, endpoints.Catalog, opts); err != nil {
+		return nil, err
+	}
+	if err := orderProto.RegisterOrderServiceHandlerFromEndpoint(ctx, mux, endpoints.Order, opts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// adminInterceptor rejects admin-only RPCs for requests that the auth
+// middleware didn't mark as admin.
+func adminInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if slices.Contains(adminMethods, method) && !auth.IsAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, auth.ErrForbidden.Error())
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// errorHandler hides the messages of internal errors, as the GraphQL error
+// presenter does, and otherwise keeps grpc-gateway's status JSON.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	if !slices.Contains(publicCodes, st.Code()) {
+		log.Printf("Error REST %s %s (request %s): %v", r.Method, r.URL.Path, requestid.FromContext(r.Context()), err)
+		code := codes.Internal
+		if st.Code() == codes.Unavailable {
+			code = codes.Unavailable
+		}
+		err = status.Error(code, "internal server error")
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
@@ -1,20 +1,41 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/cart/gateway/cartHandler"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/config"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/auth"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/graph"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/invoice"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/limits"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/persisted"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/rest"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/tracing"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/webhook"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	platformMetrics "github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/requestid"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	platformTracing "github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
@@ -25,12 +46,42 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	shutdownTracing, err := platformTracing.Setup(context.Background(), "gateway", cfg.Tracing.Exporter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Printf("Error shutting down tracing: %v", err)
+		}
+	}()
+	creds, err := mtls.NewCredentials(mtls.Config{
+		CertFile:     cfg.TLS.CertFile,
+		KeyFile:      cfg.TLS.KeyFile,
+		CAFile:       cfg.TLS.CAFile,
+		AllowedPeers: cfg.TLS.AllowedPeers,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = defaultPort
 	}
 
-	accountClient, err := accountHandler.NewGRPCAccountClient(cfg.Application.AccountPort)
+	retry := grpcx.RetryPolicy{
+		MaxAttempts:    cfg.Resilience.RetryMaxAttempts,
+		InitialBackoff: cfg.Resilience.RetryInitialBackoff,
+		MaxBackoff:     cfg.Resilience.RetryMaxBackoff,
+	}
+	breakers := make(map[string]*grpcx.Breaker)
+	for _, name := range []string{"account", "catalog", "order", "cart"} {
+		breakers[name] = grpcx.NewBreaker(name, cfg.Resilience.BreakerFailures, cfg.Resilience.BreakerCooldown)
+	}
+
+	accountClient, err := accountHandler.NewGRPCAccountClient(cfg.Application.AccountPort, creds, retry, breakers["account"])
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -40,7 +91,7 @@ func main() {
 		}
 	}()
 
-	catalogClient, err := catalogHandler.NewGRPCCatalogClient(cfg.Application.CatalogPort)
+	catalogClient, err := catalogHandler.NewGRPCCatalogClient(cfg.Application.CatalogPort, creds, retry, breakers["catalog"])
 	if err != nil {
 		defer func() {
 			if err := accountClient.Close(); err != nil {
@@ -56,7 +107,7 @@ func main() {
 		}
 	}()
 
-	orderClient, err := orderHandler.NewGRPCOrderClient(cfg.Application.OrderPort)
+	orderClient, err := orderHandler.NewGRPCOrderClient(cfg.Application.OrderPort, creds, retry, breakers["order"])
 	if err != nil {
 		defer func() {
 			if err := accountClient.Close(); err != nil {
@@ -77,21 +128,142 @@ func main() {
 		}
 	}()
 
-	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: &graph.Resolver{AccountClient: accountClient, CatalogClient: catalogClient, OrderClient: orderClient}}))
+	cartClient, err := cartHandler.NewGRPCCartClient(cfg.Application.CartPort, creds, retry, breakers["cart"])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer func() {
+		if err := cartClient.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	reportingClient, err := orderHandler.NewGRPCReportingClient(cfg.Application.OrderPort, creds, retry, breakers["order"])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer func() {
+		if err := reportingClient.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	backends := make(map[string]health.Check)
+	for name, addr := range map[string]string{
+		"account": cfg.Application.AccountPort,
+		"catalog": cfg.Application.CatalogPort,
+		"order":   cfg.Application.OrderPort,
+		"cart":    cfg.Application.CartPort,
+	} {
+		remote, err := health.NewRemote(addr, creds.DialOption())
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer func() {
+			if err := remote.Close(); err != nil {
+				log.Fatal(err)
+			}
+		}()
+		backends[name] = remote.Check
+	}
+	// An open breaker shows in the readiness details and the breaker metrics
+	// but leaves the gateway ready: every instance shares the backend it
+	// guards, so failing readiness would only add a full outage to a partial
+	// one.
+	breakerChecks := make(map[string]health.Check)
+	for name, breaker := range breakers {
+		breakerChecks[name+"_breaker"] = breaker.Check
+	}
 
+	credentials := auth.Credentials{AdminToken: cfg.Auth.AdminToken, AccountSecret: cfg.Auth.AccountSecret}
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  &graph.Resolver{AccountClient: accountClient, CatalogClient: catalogClient, OrderClient: orderClient, CartClient: cartClient, ReportingClient: reportingClient},
+		Directives: graph.DirectiveRoot{Admin: graph.Admin},
+		Complexity: graph.NewComplexityRoot(),
+	}))
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: auth.CheckOrigin(cfg.Auth.WebsocketOrigins),
+		},
+		// Subscriptions stream orders as they change, so sockets are only
+		// for signed-in callers, and the resolvers only stream the orders
+		// of the account a socket signed in as.
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			ctx, err := auth.SignIn(ctx, initPayload.Authorization(), credentials)
+			return ctx, nil, err
+		},
+	})
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+	srv.SetRecoverFunc(graph.RecoverFunc)
+
+	// In allowlist mode only manifest operations run, so clients can't
+	// register new documents through automatic persisted queries.
+	var manifest *persisted.Manifest
+	if cfg.PersistedQueries.ManifestPath != "" {
+		manifest, err = persisted.LoadManifest(cfg.PersistedQueries.ManifestPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else if cfg.PersistedQueries.Enforce {
+		log.Fatal("PERSISTED_QUERIES_ENFORCE requires PERSISTED_QUERIES_MANIFEST")
+	}
 
 	srv.Use(extension.Introspection{})
-	srv.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New[string](100),
-	})
+	srv.Use(metrics.NewOperations(manifest.Names()))
+	srv.Use(tracing.Tracer{})
+	srv.Use(limits.New(cfg.Limits.MaxDepth, cfg.Limits.MaxComplexity))
+	if manifest != nil {
+		srv.Use(persisted.NewAllowlist(manifest, cfg.PersistedQueries.Enforce))
+	}
+	if !cfg.PersistedQueries.Enforce {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: lru.New[string](100),
+		})
+	}
+
+	restCtx, restCancel := context.WithCancel(context.Background())
+	defer restCancel()
+	restHandler, err := rest.NewHandler(restCtx, rest.Endpoints{
+		Account: cfg.Application.AccountPort,
+		Catalog: cfg.Application.CatalogPort,
+		Order:   cfg.Application.OrderPort,
+	}, creds)
+	if err != nil {
+		log.Fatal(err)
+	}
+	openapiHandler, err := rest.NewOpenAPIHandler()
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	http.Handle("GET /healthz", health.LivenessHandler())
+	http.Handle("GET /readyz", health.ReadinessHandler(backends, breakerChecks))
+	// Every storefront is served on its own host, which decides whose data
+	// a request reaches.
+	withTenant := tenant.Middleware(cfg.Tenant)
+	http.Handle("/v1/", requestid.Middleware(withTenant(postgres.Middleware(auth.Middleware(credentials)(restHandler)))))
+	http.Handle("GET /openapi.json", openapiHandler)
+	http.Handle("/query", requestid.Middleware(withTenant(postgres.Middleware(auth.Middleware(credentials)(srv)))))
+	http.Handle("POST /webhooks/payments/{provider}", withTenant(webhook.NewPaymentHandler(orderClient)))
+	http.Handle("GET /invoices/{id}", withTenant(auth.Middleware(credentials)(invoice.NewDownloadHandler(orderClient))))
+
+	metricsServer := platformMetrics.NewServer(cfg.Application.MetricsPort)
+	go func() {
+		log.Printf("serving metrics on %s", cfg.Application.MetricsPort)
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("Error serving metrics: %v", err)
+		}
+	}()
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
 	log.Fatal(http.ListenAndServe(":"+port, nil))
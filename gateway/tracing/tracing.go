@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/saleh-ghazimoradi/MircoEcoMarket/gateway/tracing"
+
+// Tracer starts a span for every operation, continuing a trace context sent
+// in the request headers, and a child span for every field backed by a
+// resolver. Plain struct fields are left out; they only copy data.
+type Tracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = Tracer{}
+
+func (Tracer) ExtensionName() string {
+	return "Tracing"
+}
+
+func (Tracer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Tracer) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(http.Header(opCtx.Headers)))
+
+	opType, opName := "operation", opCtx.OperationName
+	if op := opCtx.Operation; op != nil {
+		opType, opName = string(op.Operation), op.Name
+	}
+	name := opType
+	if opName != "" {
+		name += " " + opName
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("graphql.operation.type", opType),
+			attribute.String("graphql.operation.name", opName),
+		),
+	)
+	responses := next(ctx)
+
+	// Queries and mutations produce a single response; a subscription's span
+	// lasts until its stream ends.
+	subscription := opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			span.End()
+			return nil
+		}
+		if len(resp.Errors) > 0 {
+			span.SetStatus(codes.Error, resp.Errors.Error())
+		}
+		if !subscription {
+			span.End()
+		}
+		return resp
+	}
+}
+
+func (Tracer) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(
+			attribute.String("graphql.field.path", fc.Path().String()),
+		),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
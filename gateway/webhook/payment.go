@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+)
+
+const (
+	SignatureHeader = "X-Payment-Signature"
+	maxPayloadBytes = 64 << 10
+)
+
+type paymentHandler struct {
+	orderClient orderHandler.GRPCOrderClient
+}
+
+// ServeHTTP forwards a payment provider callback to the order service, which
+// verifies the signature and applies the event to the referenced payment.
+func (p *paymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadBytes))
+	if err != nil {
+		http.Error(w, "could not read payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := p.orderClient.HandlePaymentWebhook(ctx, &dto.PaymentWebhook{
+		Provider:  r.PathValue("provider"),
+		Payload:   payload,
+		Signature: r.Header.Get(SignatureHeader),
+	}); err != nil {
+		log.Printf("Error handling payment webhook: %v", err)
+		http.Error(w, "could not handle payment event", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func NewPaymentHandler(orderClient orderHandler.GRPCOrderClient) http.Handler {
+	return &paymentHandler{
+		orderClient: orderClient,
+	}
+}
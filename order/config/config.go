@@ -1,7 +1,9 @@
 package config
 
 import (
-	"github.com/caarlos0/env/v11"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/configx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
 	"sync"
 )
 
@@ -13,13 +15,22 @@ var (
 
 type Config struct {
 	Application Application
-	Postgresql  Postgresql
+	Postgresql  postgres.Config `envPrefix:"ORDER_POSTGRES_"`
+	Payment     Payment
+	Report      Report
+	Invoice     Invoice
+	Tracing     Tracing
+	TLS         TLS
+	Resilience  Resilience
+	// Tenant is read from the gateway's settings; reports are refreshed for
+	// each tenant it serves.
+	Tenant tenant.Config
 }
 
 func NewConfig() (*Config, error) {
 	once.Do(func() {
 		instance = &Config{}
-		initErr = env.Parse(instance)
+		initErr = configx.Load(instance)
 		if initErr != nil {
 			instance = nil
 		}
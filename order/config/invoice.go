@@ -0,0 +1,6 @@
+package config
+
+type Invoice struct {
+	SellerName string  `env:"INVOICE_SELLER_NAME" envDefault:"MircoEcoMarket"`
+	TaxRate    float64 `env:"INVOICE_TAX_RATE" envDefault:"0"`
+}
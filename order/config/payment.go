@@ -0,0 +1,17 @@
+package config
+
+import "errors"
+
+type Payment struct {
+	Provider string `env:"PAYMENT_PROVIDER"`
+	// WebhookSecret signs provider callbacks, which arrive on a public
+	// endpoint; without it anyone could forge them.
+	WebhookSecret string `env:"PAYMENT_WEBHOOK_SECRET"`
+}
+
+func (p *Payment) Validate() error {
+	if p.WebhookSecret == "" {
+		return errors.New("PAYMENT_WEBHOOK_SECRET is required")
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package config
+
+import "time"
+
+type Report struct {
+	RefreshInterval time.Duration `env:"REPORT_REFRESH_INTERVAL" envDefault:"5m"`
+}
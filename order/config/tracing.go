@@ -0,0 +1,5 @@
+package config
+
+type Tracing struct {
+	Exporter string `env:"OTEL_TRACES_EXPORTER" envDefault:"none"`
+}
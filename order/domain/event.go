@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// OrderEvent announces that an order was created or changed status. It
+// carries the order as it was right after the change.
+type OrderEvent struct {
+	Order      *Order    `json:"order"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// Invoice is frozen when the order's payment is captured: lines, addresses
+// and tax are copied onto it and never recomputed. Prices are tax inclusive,
+// so Total always equals the amount the customer paid.
+type Invoice struct {
+	Id             string           `json:"id"`
+	OrderId        string           `json:"order_id"`
+	AccountId      string           `json:"account_id"`
+	Number         string           `json:"number"`
+	Year           int              `json:"year"`
+	Sequence       uint64           `json:"sequence"`
+	SellerName     string           `json:"seller_name"`
+	BillingAddress *ShippingAddress `json:"billing_address"`
+	Lines          []*InvoiceLine   `json:"lines"`
+	Subtotal       float64          `json:"subtotal"`
+	TaxRate        float64          `json:"tax_rate"`
+	Tax            float64          `json:"tax"`
+	Total          float64          `json:"total"`
+	IssuedAt       time.Time        `json:"issued_at"`
+}
+
+type InvoiceLine struct {
+	CatalogId string  `json:"catalog_id"`
+	Name      string  `json:"name"`
+	Quantity  uint32  `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	Net       float64 `json:"net"`
+	Tax       float64 `json:"tax"`
+	Total     float64 `json:"total"`
+}
+
+// InvoiceDocument is an invoice rendered into a downloadable file.
+type InvoiceDocument struct {
+	Invoice     *Invoice `json:"invoice"`
+	Content     []byte   `json:"content"`
+	ContentType string   `json:"content_type"`
+}
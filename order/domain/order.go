@@ -2,12 +2,38 @@ package domain
 
 import "time"
 
+const (
+	OrderStatusPending           = "pending"
+	OrderStatusPaid              = "paid"
+	OrderStatusPartiallyRefunded = "partially_refunded"
+	OrderStatusRefunded          = "refunded"
+	OrderStatusCancelled         = "cancelled"
+)
+
 type Order struct {
-	Id         string            `json:"id"`
-	CreatedAt  time.Time         `json:"created_at"`
-	TotalPrice float64           `json:"total_price"`
-	AccountId  string            `json:"account_id"`
-	Catalogs   []*OrderedCatalog `json:"catalogs"`
+	Id              string           `json:"id"`
+	CreatedAt       time.Time        `json:"created_at"`
+	TotalPrice      float64          `json:"total_price"`
+	AccountId       string           `json:"account_id"`
+	Status          string           `json:"status"`
+	ShippingAddress *ShippingAddress `json:"shipping_address"`
+	// CheckoutKey identifies the checkout that placed the order, if any; a
+	// tenant places at most one order per key.
+	CheckoutKey string            `json:"checkout_key,omitempty"`
+	Catalogs    []*OrderedCatalog `json:"catalogs"`
+}
+
+// ShippingAddress is the destination of an order, copied from the account's
+// address book when the order is placed so later edits don't affect it.
+type ShippingAddress struct {
+	Recipient  string `json:"recipient"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
 }
 
 type OrderedCatalog struct {
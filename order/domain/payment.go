@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+const (
+	PaymentStatusAuthorized        = "authorized"
+	PaymentStatusCaptured          = "captured"
+	PaymentStatusVoided            = "voided"
+	PaymentStatusFailed            = "failed"
+	PaymentStatusPartiallyRefunded = "partially_refunded"
+	PaymentStatusRefunded          = "refunded"
+)
+
+type Payment struct {
+	Id                string    `json:"id"`
+	OrderId           string    `json:"order_id"`
+	Provider          string    `json:"provider"`
+	ProviderReference string    `json:"provider_reference"`
+	Amount            float64   `json:"amount"`
+	RefundedAmount    float64   `json:"refunded_amount"`
+	Status            string    `json:"status"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
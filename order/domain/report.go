@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+const (
+	ReportGranularityDay   = "day"
+	ReportGranularityWeek  = "week"
+	ReportGranularityMonth = "month"
+)
+
+const (
+	ProductRankingQuantity = "quantity"
+	ProductRankingRevenue  = "revenue"
+)
+
+// SalesStatuses are the order statuses counted as sales. Revenue is gross:
+// refunded orders still count at their ordered total.
+var SalesStatuses = []string{OrderStatusPaid, OrderStatusPartiallyRefunded, OrderStatusRefunded}
+
+type RevenueBucket struct {
+	PeriodStart time.Time `json:"period_start"`
+	Revenue     float64   `json:"revenue"`
+	OrderCount  uint64    `json:"order_count"`
+}
+
+type ProductSales struct {
+	CatalogId string  `json:"catalog_id"`
+	Quantity  uint64  `json:"quantity"`
+	Revenue   float64 `json:"revenue"`
+}
+
+type SalesSummary struct {
+	Revenue           float64 `json:"revenue"`
+	OrderCount        uint64  `json:"order_count"`
+	AverageOrderValue float64 `json:"average_order_value"`
+}
+
+type CustomerBreakdown struct {
+	NewCustomers       uint64 `json:"new_customers"`
+	ReturningCustomers uint64 `json:"returning_customers"`
+}
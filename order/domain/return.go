@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+const (
+	ReturnStatusRequested = "requested"
+	ReturnStatusApproved  = "approved"
+	ReturnStatusRejected  = "rejected"
+	ReturnStatusReceived  = "received"
+	// ReturnStatusRefunding holds a return while its line is refunded, so
+	// that no other call refunds it too.
+	ReturnStatusRefunding = "refunding"
+	ReturnStatusRefunded  = "refunded"
+)
+
+type OrderReturn struct {
+	Id                string    `json:"id"`
+	OrderId           string    `json:"order_id"`
+	CatalogId         string    `json:"catalog_id"`
+	Quantity          uint32    `json:"quantity"`
+	RestockedQuantity uint32    `json:"restocked_quantity"`
+	Reason            string    `json:"reason"`
+	Status            string    `json:"status"`
+	RefundAmount      float64   `json:"refund_amount"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
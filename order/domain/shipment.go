@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+const (
+	ShipmentStatusLabelCreated   = "label_created"
+	ShipmentStatusInTransit      = "in_transit"
+	ShipmentStatusOutForDelivery = "out_for_delivery"
+	ShipmentStatusDelivered      = "delivered"
+	ShipmentStatusException      = "exception"
+)
+
+type Shipment struct {
+	Id             string           `json:"id"`
+	OrderId        string           `json:"order_id"`
+	Carrier        string           `json:"carrier"`
+	TrackingNumber string           `json:"tracking_number"`
+	Status         string           `json:"status"`
+	Lines          []*ShipmentLine  `json:"lines"`
+	Events         []*ShipmentEvent `json:"events"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+type ShipmentLine struct {
+	CatalogId string `json:"catalog_id"`
+	Quantity  uint32 `json:"quantity"`
+}
+
+type ShipmentEvent struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
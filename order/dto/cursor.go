@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+var ErrInvalidCursor = errors.New("invalid input: malformed cursor")
+
+// OrderCursor is the keyset position of an order in an account's order
+// history, which is sorted by creation time and then id.
+type OrderCursor struct {
+	CreatedAt time.Time
+	Id        string
+}
+
+func EncodeOrderCursor(createdAt time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.UTC().Format(time.RFC3339Nano) + "|" + id))
+}
+
+func DecodeOrderCursor(cursor string) (*OrderCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &OrderCursor{CreatedAt: t, Id: id}, nil
+}
@@ -1,8 +1,24 @@
 package dto
 
+import "time"
+
 type Order struct {
-	AccountId string `json:"account_id"`
-	Catalogs  []*OrderedCatalog
+	AccountId         string           `json:"account_id"`
+	ShippingAddressId string           `json:"shipping_address_id"`
+	ShippingAddress   *ShippingAddress `json:"shipping_address"`
+	CheckoutKey       string           `json:"checkout_key"`
+	Catalogs          []*OrderedCatalog
+}
+
+type ShippingAddress struct {
+	Recipient  string `json:"recipient"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
 }
 
 type OrderedCatalog struct {
@@ -12,3 +28,80 @@ type OrderedCatalog struct {
 	Price       float64 `json:"price"`
 	Quantity    uint32  `json:"quantity"`
 }
+
+type PayOrder struct {
+	OrderId       string `json:"order_id"`
+	PaymentMethod string `json:"payment_method"`
+}
+
+type RefundOrder struct {
+	OrderId string  `json:"order_id"`
+	Amount  float64 `json:"amount"`
+}
+
+type PaymentWebhook struct {
+	Provider  string `json:"provider"`
+	Payload   []byte `json:"payload"`
+	Signature string `json:"signature"`
+}
+
+type ReturnLine struct {
+	CatalogId string `json:"catalog_id"`
+	Quantity  uint32 `json:"quantity"`
+}
+
+type RequestReturn struct {
+	OrderId string        `json:"order_id"`
+	Reason  string        `json:"reason"`
+	Lines   []*ReturnLine `json:"lines"`
+}
+
+type ShipmentLine struct {
+	CatalogId string `json:"catalog_id"`
+	Quantity  uint32 `json:"quantity"`
+}
+
+type CreateShipment struct {
+	OrderId        string          `json:"order_id"`
+	Carrier        string          `json:"carrier"`
+	TrackingNumber string          `json:"tracking_number"`
+	Lines          []*ShipmentLine `json:"lines"`
+}
+
+type UpdateShipmentStatus struct {
+	ShipmentId  string `json:"shipment_id"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	Location    string `json:"location"`
+}
+
+type OrderQuery struct {
+	AccountId   string    `json:"account_id"`
+	CreatedFrom time.Time `json:"created_from"`
+	CreatedTo   time.Time `json:"created_to"`
+	Statuses    []string  `json:"statuses"`
+	MinTotal    *float64  `json:"min_total"`
+	MaxTotal    *float64  `json:"max_total"`
+	Sort        string    `json:"sort"`
+	Limit       uint64    `json:"limit"`
+	Cursor      string    `json:"cursor"`
+}
+
+type SalesReportQuery struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	Granularity string    `json:"granularity"`
+	Limit       uint64    `json:"limit"`
+	RankBy      string    `json:"rank_by"`
+}
+
+type GetInvoice struct {
+	InvoiceId string `json:"invoice_id"`
+	AccountId string `json:"account_id"`
+	Format    string `json:"format"`
+}
+
+type WatchOrders struct {
+	OrderId   string `json:"order_id"`
+	AccountId string `json:"account_id"`
+}
@@ -0,0 +1,87 @@
+package events
+
+import (
+	"context"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"sync"
+)
+
+const subscriberBuffer = 16
+
+// Filter selects the events a subscriber receives. Events of other tenants
+// never match; an empty OrderId or AccountId matches every order of Tenant.
+type Filter struct {
+	Tenant    string
+	OrderId   string
+	AccountId string
+}
+
+func (f Filter) matches(tenant string, event *domain.OrderEvent) bool {
+	return f.Tenant == tenant &&
+		(f.OrderId == "" || f.OrderId == event.Order.Id) &&
+		(f.AccountId == "" || f.AccountId == event.Order.AccountId)
+}
+
+// Broadcaster fans order events out to the subscribers of this process. It
+// is in-memory, so each order service instance only sees its own changes.
+type Broadcaster interface {
+	Publish(ctx context.Context, event *domain.OrderEvent)
+	Subscribe(filter Filter) (<-chan *domain.OrderEvent, func())
+}
+
+type subscriber struct {
+	filter Filter
+	ch     chan *domain.OrderEvent
+}
+
+type broadcaster struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+// Publish sends event to the subscribers of the tenant of ctx. It never
+// blocks: a subscriber whose buffer is full misses the event rather than
+// holding up the order change that caused it.
+func (b *broadcaster) Publish(ctx context.Context, event *domain.OrderEvent) {
+	id := tenant.FromContext(ctx)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for s := range b.subscribers {
+		if !s.filter.matches(id, event) {
+			continue
+		}
+		select {
+		case s.ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns the subscriber's events and a function that unsubscribes
+// and closes the channel.
+func (b *broadcaster) Subscribe(filter Filter) (<-chan *domain.OrderEvent, func()) {
+	s := &subscriber{
+		filter: filter,
+		ch:     make(chan *domain.OrderEvent, subscriberBuffer),
+	}
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return s.ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, s)
+			b.mu.Unlock()
+			close(s.ch)
+		})
+	}
+}
+
+func NewBroadcaster() Broadcaster {
+	return &broadcaster{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
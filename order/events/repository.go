@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"log/slog"
+	"time"
+)
+
+// orderRepository publishes an event for every order it creates or moves to
+// a new status, so no service has to remember to announce its changes.
+type orderRepository struct {
+	next        repository.OrderRepository
+	broadcaster Broadcaster
+}
+
+func (o *orderRepository) CreateOrder(ctx context.Context, order *domain.Order) error {
+	if err := o.next.CreateOrder(ctx, order); err != nil {
+		return err
+	}
+	o.broadcaster.Publish(ctx, &domain.OrderEvent{Order: order, OccurredAt: time.Now().UTC()})
+	return nil
+}
+
+func (o *orderRepository) GetOrderById(ctx context.Context, id string) (*domain.Order, error) {
+	return o.next.GetOrderById(ctx, id)
+}
+
+func (o *orderRepository) GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error) {
+	return o.next.GetOrdersByIds(ctx, ids)
+}
+
+func (o *orderRepository) GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, error) {
+	return o.next.GetOrdersForAccount(ctx, query)
+}
+
+func (o *orderRepository) UpdateOrderStatus(ctx context.Context, id, status string) error {
+	if err := o.next.UpdateOrderStatus(ctx, id, status); err != nil {
+		return err
+	}
+	order, err := o.next.GetOrderById(ctx, id)
+	if err != nil {
+		slog.Error("order.event.lookup.failed", slog.String("order_id", id), slog.String("error", err.Error()))
+		return nil
+	}
+	o.broadcaster.Publish(ctx, &domain.OrderEvent{Order: order, OccurredAt: time.Now().UTC()})
+	return nil
+}
+
+func NewOrderRepository(next repository.OrderRepository, broadcaster Broadcaster) repository.OrderRepository {
+	return &orderRepository{
+		next:        next,
+		broadcaster: broadcaster,
+	}
+}
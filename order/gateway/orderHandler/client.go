@@ -2,18 +2,40 @@ package orderHandler
 
 import (
 	"context"
+	"errors"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"log"
+	"io"
+	"log/slog"
 	"time"
 )
 
 type GRPCOrderClient interface {
 	CreateOrder(ctx context.Context, input *dto.Order) (*domain.Order, error)
-	GetOrdersForAccount(ctx context.Context, accountId string) ([]*domain.Order, error)
+	GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error)
+	GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, string, error)
+	PayOrder(ctx context.Context, input *dto.PayOrder) (*domain.Payment, error)
+	RefundOrder(ctx context.Context, input *dto.RefundOrder) (*domain.Payment, error)
+	HandlePaymentWebhook(ctx context.Context, input *dto.PaymentWebhook) error
+	CancelOrder(ctx context.Context, orderId string) (*domain.Order, error)
+	RequestReturn(ctx context.Context, input *dto.RequestReturn) ([]*domain.OrderReturn, error)
+	ApproveReturn(ctx context.Context, returnId string) (*domain.OrderReturn, error)
+	RejectReturn(ctx context.Context, returnId string) (*domain.OrderReturn, error)
+	ReceiveReturn(ctx context.Context, returnId string) (*domain.OrderReturn, error)
+	GetReturnsForOrder(ctx context.Context, orderId string) ([]*domain.OrderReturn, error)
+	CreateShipment(ctx context.Context, input *dto.CreateShipment) (*domain.Shipment, error)
+	UpdateShipmentStatus(ctx context.Context, input *dto.UpdateShipmentStatus) (*domain.Shipment, error)
+	GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error)
+	GetInvoice(ctx context.Context, input *dto.GetInvoice) (*domain.InvoiceDocument, error)
+	GetInvoiceForOrder(ctx context.Context, orderId string) (*domain.Invoice, error)
+	WatchOrders(ctx context.Context, input *dto.WatchOrders) (<-chan *domain.OrderEvent, error)
 	Close() error
 }
 
@@ -32,26 +54,319 @@ func (g *gRPCOrderClient) CreateOrder(ctx context.Context, input *dto.Order) (*d
 		})
 	}
 
+	var shippingAddress *proto.ShippingAddress
+	if a := input.ShippingAddress; a != nil {
+		shippingAddress = &proto.ShippingAddress{
+			Recipient:  a.Recipient,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+		}
+	}
+
 	req, err := g.client.CreateOrder(ctx, &proto.CreateOrderRequest{
+		AccountId:         input.AccountId,
+		Catalogs:          protoCatalogs,
+		ShippingAddress:   shippingAddress,
+		ShippingAddressId: input.ShippingAddressId,
+		CheckoutKey:       input.CheckoutKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOrder(req.Order)
+}
+
+func (g *gRPCOrderClient) GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error) {
+	resp, err := g.client.GetOrdersByIds(ctx, &proto.GetOrdersByIdsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*domain.Order, len(resp.Orders))
+	for i, o := range resp.Orders {
+		order, err := toDomainOrder(o)
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+	return orders, nil
+}
+
+func (g *gRPCOrderClient) GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, string, error) {
+	req := &proto.GetOrdersForAccountRequest{
+		AccountId: query.AccountId,
+		Statuses:  query.Statuses,
+		MinTotal:  query.MinTotal,
+		MaxTotal:  query.MaxTotal,
+		Sort:      query.Sort,
+		Limit:     query.Limit,
+		Cursor:    query.Cursor,
+	}
+	if !query.CreatedFrom.IsZero() {
+		req.CreatedFrom, _ = query.CreatedFrom.MarshalBinary()
+	}
+	if !query.CreatedTo.IsZero() {
+		req.CreatedTo, _ = query.CreatedTo.MarshalBinary()
+	}
+
+	resp, err := g.client.GetOrdersForAccount(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders := make([]*domain.Order, len(resp.Orders))
+	for i, o := range resp.Orders {
+		order, err := toDomainOrder(o)
+		if err != nil {
+			return nil, "", err
+		}
+		orders[i] = order
+	}
+	return orders, resp.NextCursor, nil
+}
+
+func (g *gRPCOrderClient) PayOrder(ctx context.Context, input *dto.PayOrder) (*domain.Payment, error) {
+	resp, err := g.client.PayOrder(ctx, &proto.PayOrderRequest{
+		OrderId:       input.OrderId,
+		PaymentMethod: input.PaymentMethod,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPayment(resp.Payment)
+}
+
+func (g *gRPCOrderClient) RefundOrder(ctx context.Context, input *dto.RefundOrder) (*domain.Payment, error) {
+	resp, err := g.client.RefundOrder(ctx, &proto.RefundOrderRequest{
+		OrderId: input.OrderId,
+		Amount:  input.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPayment(resp.Payment)
+}
+
+func (g *gRPCOrderClient) HandlePaymentWebhook(ctx context.Context, input *dto.PaymentWebhook) error {
+	_, err := g.client.HandlePaymentWebhook(ctx, &proto.HandlePaymentWebhookRequest{
+		Provider:  input.Provider,
+		Payload:   input.Payload,
+		Signature: input.Signature,
+	})
+	if err != nil {
+	}
+	return err
+}
+
+func (g *gRPCOrderClient) CancelOrder(ctx context.Context, orderId string) (*domain.Order, error) {
+	resp, err := g.client.CancelOrder(ctx, &proto.CancelOrderRequest{OrderId: orderId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrder(resp.Order)
+}
+
+func (g *gRPCOrderClient) RequestReturn(ctx context.Context, input *dto.RequestReturn) ([]*domain.OrderReturn, error) {
+	lines := make([]*proto.RequestReturnRequest_ReturnLine, 0, len(input.Lines))
+	for _, l := range input.Lines {
+		lines = append(lines, &proto.RequestReturnRequest_ReturnLine{
+			CatalogId: l.CatalogId,
+			Quantity:  l.Quantity,
+		})
+	}
+
+	resp, err := g.client.RequestReturn(ctx, &proto.RequestReturnRequest{
+		OrderId: input.OrderId,
+		Reason:  input.Reason,
+		Lines:   lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainReturns(resp.Returns)
+}
+
+func (g *gRPCOrderClient) ApproveReturn(ctx context.Context, returnId string) (*domain.OrderReturn, error) {
+	resp, err := g.client.ApproveReturn(ctx, &proto.ReviewReturnRequest{ReturnId: returnId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainReturn(resp.Return)
+}
+
+func (g *gRPCOrderClient) RejectReturn(ctx context.Context, returnId string) (*domain.OrderReturn, error) {
+	resp, err := g.client.RejectReturn(ctx, &proto.ReviewReturnRequest{ReturnId: returnId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainReturn(resp.Return)
+}
+
+func (g *gRPCOrderClient) ReceiveReturn(ctx context.Context, returnId string) (*domain.OrderReturn, error) {
+	resp, err := g.client.ReceiveReturn(ctx, &proto.ReviewReturnRequest{ReturnId: returnId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainReturn(resp.Return)
+}
+
+func (g *gRPCOrderClient) GetReturnsForOrder(ctx context.Context, orderId string) ([]*domain.OrderReturn, error) {
+	resp, err := g.client.GetReturnsForOrder(ctx, &proto.GetReturnsForOrderRequest{OrderId: orderId})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainReturns(resp.Returns)
+}
+
+func (g *gRPCOrderClient) CreateShipment(ctx context.Context, input *dto.CreateShipment) (*domain.Shipment, error) {
+	lines := make([]*proto.CreateShipmentRequest_ShipmentLine, 0, len(input.Lines))
+	for _, l := range input.Lines {
+		lines = append(lines, &proto.CreateShipmentRequest_ShipmentLine{
+			CatalogId: l.CatalogId,
+			Quantity:  l.Quantity,
+		})
+	}
+
+	resp, err := g.client.CreateShipment(ctx, &proto.CreateShipmentRequest{
+		OrderId:        input.OrderId,
+		Carrier:        input.Carrier,
+		TrackingNumber: input.TrackingNumber,
+		Lines:          lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainShipment(resp.Shipment)
+}
+
+func (g *gRPCOrderClient) UpdateShipmentStatus(ctx context.Context, input *dto.UpdateShipmentStatus) (*domain.Shipment, error) {
+	resp, err := g.client.UpdateShipmentStatus(ctx, &proto.UpdateShipmentStatusRequest{
+		ShipmentId:  input.ShipmentId,
+		Status:      input.Status,
+		Description: input.Description,
+		Location:    input.Location,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainShipment(resp.Shipment)
+}
+
+func (g *gRPCOrderClient) GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error) {
+	resp, err := g.client.GetShipmentsForOrder(ctx, &proto.GetShipmentsForOrderRequest{OrderId: orderId})
+	if err != nil {
+		return nil, err
+	}
+
+	shipments := make([]*domain.Shipment, 0, len(resp.Shipments))
+	for _, s := range resp.Shipments {
+		shipment, err := toDomainShipment(s)
+		if err != nil {
+			return nil, err
+		}
+		shipments = append(shipments, shipment)
+	}
+	return shipments, nil
+}
+
+func (g *gRPCOrderClient) GetInvoice(ctx context.Context, input *dto.GetInvoice) (*domain.InvoiceDocument, error) {
+	resp, err := g.client.GetInvoice(ctx, &proto.GetInvoiceRequest{
+		InvoiceId: input.InvoiceId,
 		AccountId: input.AccountId,
-		Catalogs:  protoCatalogs,
+		Format:    input.Format,
 	})
 	if err != nil {
-		log.Printf("error creating order: %v", err)
 		return nil, err
 	}
 
-	var createdAt time.Time
+	invoice, err := toDomainInvoice(resp.Invoice)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.InvoiceDocument{
+		Invoice:     invoice,
+		Content:     resp.Content,
+		ContentType: resp.ContentType,
+	}, nil
+}
+
+// GetInvoiceForOrder returns nil without an error while the order is unpaid.
+func (g *gRPCOrderClient) GetInvoiceForOrder(ctx context.Context, orderId string) (*domain.Invoice, error) {
+	resp, err := g.client.GetInvoiceForOrder(ctx, &proto.GetInvoiceForOrderRequest{OrderId: orderId})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Invoice == nil {
+		return nil, nil
+	}
+	return toDomainInvoice(resp.Invoice)
+}
 
-	if len(req.Order.CreatedAt) > 0 {
-		if err := createdAt.UnmarshalBinary(req.Order.CreatedAt); err != nil {
-			log.Printf("Error unmarshaling CreatedAt: %v", err)
+// WatchOrders delivers order events until ctx is done or the stream breaks,
+// then closes the returned channel.
+func (g *gRPCOrderClient) WatchOrders(ctx context.Context, input *dto.WatchOrders) (<-chan *domain.OrderEvent, error) {
+	stream, err := g.client.WatchOrders(ctx, &proto.WatchOrdersRequest{
+		OrderId:   input.OrderId,
+		AccountId: input.AccountId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *domain.OrderEvent)
+	go func() {
+		defer close(events)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil && !errors.Is(err, io.EOF) {
+					slog.ErrorContext(ctx, "order.client.watch.failed", slog.String("error", err.Error()))
+				}
+				return
+			}
+
+			order, err := toDomainOrder(resp.Order)
+			if err != nil {
+				return
+			}
+			var occurredAt time.Time
+			if err := occurredAt.UnmarshalBinary(resp.OccurredAt); err != nil {
+				slog.Error("order.client.decode.failed", slog.String("field", "OccurredAt"), slog.String("error", err.Error()))
+				return
+			}
+
+			select {
+			case events <- &domain.OrderEvent{Order: order, OccurredAt: occurredAt}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func (g *gRPCOrderClient) Close() error {
+	return g.conn.Close()
+}
+
+func toDomainOrder(o *proto.Order) (*domain.Order, error) {
+	var createdAt time.Time
+	if len(o.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(o.CreatedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "CreatedAt"), slog.String("error", err.Error()))
 			return nil, err
 		}
 	}
 
-	catalogs := make([]*domain.OrderedCatalog, len(req.Order.Catalogs))
-	for i, c := range req.Order.Catalogs {
+	catalogs := make([]*domain.OrderedCatalog, len(o.Catalogs))
+	for i, c := range o.Catalogs {
 		catalogs[i] = &domain.OrderedCatalog{
 			Id:          c.Id,
 			Name:        c.Name,
@@ -62,59 +377,196 @@ func (g *gRPCOrderClient) CreateOrder(ctx context.Context, input *dto.Order) (*d
 	}
 
 	return &domain.Order{
-		Id:         req.Order.Id,
-		CreatedAt:  createdAt,
-		TotalPrice: req.Order.TotalPrice,
-		AccountId:  req.Order.AccountId,
-		Catalogs:   catalogs,
+		Id:              o.Id,
+		CreatedAt:       createdAt,
+		TotalPrice:      o.TotalPrice,
+		AccountId:       o.AccountId,
+		Status:          o.Status,
+		ShippingAddress: toDomainShippingAddress(o.ShippingAddress),
+		Catalogs:        catalogs,
 	}, nil
 }
 
-func (g *gRPCOrderClient) GetOrdersForAccount(ctx context.Context, accountId string) ([]*domain.Order, error) {
-	resp, err := g.client.GetOrdersForAccount(ctx, &proto.GetOrdersForAccountRequest{
-		AccountId: accountId,
-	})
-	if err != nil {
-		log.Printf("Error getting orders for account %s: %v", accountId, err)
-		return nil, err
+func toDomainShippingAddress(a *proto.ShippingAddress) *domain.ShippingAddress {
+	if a == nil {
+		return nil
+	}
+	return &domain.ShippingAddress{
+		Recipient:  a.Recipient,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Phone:      a.Phone,
 	}
+}
 
-	orders := make([]*domain.Order, len(resp.Orders))
-	for i, o := range resp.Orders {
-		var createdAt time.Time
-		if len(o.CreatedAt) > 0 {
-			if err := createdAt.UnmarshalBinary(o.CreatedAt); err != nil {
-				log.Printf("Error unmarshaling CreatedAt: %v", err)
+func toDomainInvoice(i *proto.Invoice) (*domain.Invoice, error) {
+	var issuedAt time.Time
+	if len(i.IssuedAt) > 0 {
+		if err := issuedAt.UnmarshalBinary(i.IssuedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "IssuedAt"), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	lines := make([]*domain.InvoiceLine, len(i.Lines))
+	for n, l := range i.Lines {
+		lines[n] = &domain.InvoiceLine{
+			CatalogId: l.CatalogId,
+			Name:      l.Name,
+			Quantity:  l.Quantity,
+			UnitPrice: l.UnitPrice,
+			Net:       l.Net,
+			Tax:       l.Tax,
+			Total:     l.Total,
+		}
+	}
+
+	return &domain.Invoice{
+		Id:             i.Id,
+		OrderId:        i.OrderId,
+		AccountId:      i.AccountId,
+		Number:         i.Number,
+		SellerName:     i.SellerName,
+		BillingAddress: toDomainShippingAddress(i.BillingAddress),
+		Lines:          lines,
+		Subtotal:       i.Subtotal,
+		TaxRate:        i.TaxRate,
+		Tax:            i.Tax,
+		Total:          i.Total,
+		IssuedAt:       issuedAt,
+	}, nil
+}
+
+func toDomainShipment(s *proto.Shipment) (*domain.Shipment, error) {
+	var createdAt, updatedAt time.Time
+	if len(s.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(s.CreatedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "CreatedAt"), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+	if len(s.UpdatedAt) > 0 {
+		if err := updatedAt.UnmarshalBinary(s.UpdatedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "UpdatedAt"), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	lines := make([]*domain.ShipmentLine, len(s.Lines))
+	for i, l := range s.Lines {
+		lines[i] = &domain.ShipmentLine{
+			CatalogId: l.CatalogId,
+			Quantity:  l.Quantity,
+		}
+	}
+
+	events := make([]*domain.ShipmentEvent, len(s.Events))
+	for i, e := range s.Events {
+		var occurredAt time.Time
+		if len(e.OccurredAt) > 0 {
+			if err := occurredAt.UnmarshalBinary(e.OccurredAt); err != nil {
+				slog.Error("order.client.decode.failed", slog.String("field", "OccurredAt"), slog.String("error", err.Error()))
 				return nil, err
 			}
 		}
-		catalogs := make([]*domain.OrderedCatalog, len(o.Catalogs))
-		for i, c := range o.Catalogs {
-			catalogs[i] = &domain.OrderedCatalog{
-				Id:          c.Id,
-				Name:        c.Name,
-				Description: c.Description,
-				Price:       c.Price,
-				Quantity:    c.Quantity,
-			}
+		events[i] = &domain.ShipmentEvent{
+			Status:      e.Status,
+			Description: e.Description,
+			Location:    e.Location,
+			OccurredAt:  occurredAt,
+		}
+	}
+
+	return &domain.Shipment{
+		Id:             s.Id,
+		OrderId:        s.OrderId,
+		Carrier:        s.Carrier,
+		TrackingNumber: s.TrackingNumber,
+		Status:         s.Status,
+		Lines:          lines,
+		Events:         events,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+func toDomainReturn(r *proto.OrderReturn) (*domain.OrderReturn, error) {
+	var createdAt, updatedAt time.Time
+	if len(r.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(r.CreatedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "CreatedAt"), slog.String("error", err.Error()))
+			return nil, err
 		}
-		orders[i] = &domain.Order{
-			Id:         o.Id,
-			CreatedAt:  createdAt,
-			TotalPrice: o.TotalPrice,
-			AccountId:  o.AccountId,
-			Catalogs:   catalogs,
+	}
+	if len(r.UpdatedAt) > 0 {
+		if err := updatedAt.UnmarshalBinary(r.UpdatedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "UpdatedAt"), slog.String("error", err.Error()))
+			return nil, err
 		}
 	}
-	return orders, nil
+	return &domain.OrderReturn{
+		Id:                r.Id,
+		OrderId:           r.OrderId,
+		CatalogId:         r.CatalogId,
+		Quantity:          r.Quantity,
+		RestockedQuantity: r.RestockedQuantity,
+		Reason:            r.Reason,
+		Status:            r.Status,
+		RefundAmount:      r.RefundAmount,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+	}, nil
 }
 
-func (g *gRPCOrderClient) Close() error {
-	return g.conn.Close()
+func toDomainReturns(returns []*proto.OrderReturn) ([]*domain.OrderReturn, error) {
+	result := make([]*domain.OrderReturn, 0, len(returns))
+	for _, r := range returns {
+		ret, err := toDomainReturn(r)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ret)
+	}
+	return result, nil
+}
+
+func toDomainPayment(p *proto.Payment) (*domain.Payment, error) {
+	var createdAt time.Time
+	if len(p.CreatedAt) > 0 {
+		if err := createdAt.UnmarshalBinary(p.CreatedAt); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "CreatedAt"), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+	return &domain.Payment{
+		Id:                p.Id,
+		OrderId:           p.OrderId,
+		Provider:          p.Provider,
+		ProviderReference: p.ProviderReference,
+		Amount:            p.Amount,
+		RefundedAmount:    p.RefundedAmount,
+		Status:            p.Status,
+		CreatedAt:         createdAt,
+	}, nil
 }
 
-func NewGRPCOrderClient(addr string) (GRPCOrderClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+var orderReads = []string{"GetOrdersByIds", "GetOrdersForAccount", "GetReturnsForOrder", "GetShipmentsForOrder", "GetInvoice", "GetInvoiceForOrder"}
+
+func NewGRPCOrderClient(addr string, creds *mtls.Credentials, retry grpcx.RetryPolicy, breaker *grpcx.Breaker, dialOpts ...grpc.DialOption) (GRPCOrderClient, error) {
+	opts := []grpc.DialOption{
+		creds.DialOption(),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), postgres.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor()),
+	}
+	opts = append(opts, grpcx.DialOptions()...)
+	opts = append(opts, grpcx.ResilienceOptions(proto.OrderService_ServiceDesc.ServiceName, orderReads, retry, breaker)...)
+	opts = append(opts, dialOpts...)
+	conn, err := grpc.NewClient(addr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package orderHandler
+
+import (
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/invoice"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/payment"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errInvalidTimestamp        = errors.New("invalid input: malformed timestamp")
+	errMissingWatchFilter      = errors.New("invalid input: order id or account id is required")
+	errShippingAddressNotFound = errors.New("shipping address not found")
+)
+
+var errorMapper = grpcx.NewErrorMapper("order",
+	grpcx.ErrorRule{Err: repository.ErrNoRows, Code: codes.NotFound, Reason: "NOT_FOUND"},
+	grpcx.ErrorRule{Err: errShippingAddressNotFound, Code: codes.NotFound, Reason: "SHIPPING_ADDRESS_NOT_FOUND"},
+	grpcx.ErrorRule{Err: payment.ErrUnknownReference, Code: codes.NotFound, Reason: "PAYMENT_NOT_FOUND"},
+
+	grpcx.ErrorRule{Err: errInvalidTimestamp, Code: codes.InvalidArgument, Reason: "INVALID_TIMESTAMP"},
+	grpcx.ErrorRule{Err: errMissingWatchFilter, Code: codes.InvalidArgument, Reason: "MISSING_WATCH_FILTER"},
+	grpcx.ErrorRule{Err: dto.ErrInvalidCursor, Code: codes.InvalidArgument, Reason: "INVALID_CURSOR", Field: "cursor"},
+	grpcx.ErrorRule{Err: service.ErrInvalidOrderQuery, Code: codes.InvalidArgument, Reason: "INVALID_ORDER_QUERY"},
+	grpcx.ErrorRule{Err: service.ErrTooManyIds, Code: codes.InvalidArgument, Reason: "TOO_MANY_IDS", Field: "ids"},
+	grpcx.ErrorRule{Err: service.ErrInvalidSort, Code: codes.InvalidArgument, Reason: "INVALID_SORT", Field: "sort"},
+	grpcx.ErrorRule{Err: service.ErrInvalidRefundAmount, Code: codes.InvalidArgument, Reason: "INVALID_REFUND_AMOUNT", Field: "amount"},
+	grpcx.ErrorRule{Err: service.ErrInvalidReturnQuantity, Code: codes.InvalidArgument, Reason: "INVALID_RETURN_QUANTITY"},
+	grpcx.ErrorRule{Err: service.ErrInvalidReturnLine, Code: codes.InvalidArgument, Reason: "INVALID_RETURN_LINE"},
+	grpcx.ErrorRule{Err: service.ErrInvalidReturnLineCount, Code: codes.InvalidArgument, Reason: "INVALID_RETURN_LINE_COUNT", Field: "lines"},
+	grpcx.ErrorRule{Err: service.ErrInvalidShipment, Code: codes.InvalidArgument, Reason: "INVALID_SHIPMENT"},
+	grpcx.ErrorRule{Err: service.ErrInvalidShipmentLineCount, Code: codes.InvalidArgument, Reason: "INVALID_SHIPMENT_LINE_COUNT", Field: "lines"},
+	grpcx.ErrorRule{Err: service.ErrInvalidShipmentLine, Code: codes.InvalidArgument, Reason: "INVALID_SHIPMENT_LINE"},
+	grpcx.ErrorRule{Err: service.ErrDuplicateShipmentLine, Code: codes.InvalidArgument, Reason: "DUPLICATE_SHIPMENT_LINE", Field: "lines"},
+	grpcx.ErrorRule{Err: service.ErrInvalidShipmentQuantity, Code: codes.InvalidArgument, Reason: "INVALID_SHIPMENT_QUANTITY"},
+	grpcx.ErrorRule{Err: service.ErrInvalidShipmentStatus, Code: codes.InvalidArgument, Reason: "INVALID_SHIPMENT_STATUS", Field: "status"},
+	grpcx.ErrorRule{Err: service.ErrInvalidReportRange, Code: codes.InvalidArgument, Reason: "INVALID_REPORT_RANGE"},
+	grpcx.ErrorRule{Err: service.ErrInvalidGranularity, Code: codes.InvalidArgument, Reason: "INVALID_GRANULARITY", Field: "granularity"},
+	grpcx.ErrorRule{Err: service.ErrInvalidProductRank, Code: codes.InvalidArgument, Reason: "INVALID_PRODUCT_RANK", Field: "rankBy"},
+	grpcx.ErrorRule{Err: invoice.ErrUnsupportedFormat, Code: codes.InvalidArgument, Reason: "UNSUPPORTED_INVOICE_FORMAT", Field: "format"},
+	grpcx.ErrorRule{Err: payment.ErrInvalidSignature, Code: codes.PermissionDenied, Reason: "INVALID_WEBHOOK_SIGNATURE"},
+
+	grpcx.ErrorRule{Err: service.ErrOrderNotCancellable, Code: codes.FailedPrecondition, Reason: "ORDER_NOT_CANCELLABLE"},
+	grpcx.ErrorRule{Err: service.ErrOrderNotPayable, Code: codes.FailedPrecondition, Reason: "ORDER_NOT_PAYABLE"},
+	grpcx.ErrorRule{Err: service.ErrPaymentNotRefundable, Code: codes.FailedPrecondition, Reason: "PAYMENT_NOT_REFUNDABLE"},
+	grpcx.ErrorRule{Err: service.ErrOrderNotReturnable, Code: codes.FailedPrecondition, Reason: "ORDER_NOT_RETURNABLE"},
+	grpcx.ErrorRule{Err: service.ErrReturnStatus, Code: codes.FailedPrecondition, Reason: "RETURN_STATUS"},
+	grpcx.ErrorRule{Err: service.ErrOrderNotShippable, Code: codes.FailedPrecondition, Reason: "ORDER_NOT_SHIPPABLE"},
+	grpcx.ErrorRule{Err: service.ErrMissingShippingAddress, Code: codes.FailedPrecondition, Reason: "MISSING_SHIPPING_ADDRESS"},
+	grpcx.ErrorRule{Err: service.ErrShipmentStatus, Code: codes.FailedPrecondition, Reason: "SHIPMENT_STATUS"},
+	grpcx.ErrorRule{Err: service.ErrOrderNotInvoiceable, Code: codes.FailedPrecondition, Reason: "ORDER_NOT_INVOICEABLE"},
+	grpcx.ErrorRule{Err: payment.ErrDeclined, Code: codes.FailedPrecondition, Reason: "PAYMENT_DECLINED"},
+	grpcx.ErrorRule{Err: service.ErrUnknownProvider, Code: codes.InvalidArgument, Reason: "UNKNOWN_PAYMENT_PROVIDER", Field: "provider"},
+)
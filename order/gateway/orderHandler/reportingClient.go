@@ -0,0 +1,137 @@
+package orderHandler
+
+import (
+	"context"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
+	"google.golang.org/grpc"
+	"log/slog"
+	"time"
+)
+
+type GRPCReportingClient interface {
+	GetRevenue(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.RevenueBucket, error)
+	GetTopProducts(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.ProductSales, error)
+	GetSalesSummary(ctx context.Context, query *dto.SalesReportQuery) (*domain.SalesSummary, error)
+	GetCustomerBreakdown(ctx context.Context, query *dto.SalesReportQuery) (*domain.CustomerBreakdown, error)
+	Close() error
+}
+
+type gRPCReportingClient struct {
+	conn   *grpc.ClientConn
+	client proto.ReportingClient
+}
+
+func (g *gRPCReportingClient) GetRevenue(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.RevenueBucket, error) {
+	from, to := marshalRange(query)
+	resp, err := g.client.GetRevenue(ctx, &proto.RevenueRequest{
+		From:        from,
+		To:          to,
+		Granularity: query.Granularity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]*domain.RevenueBucket, 0, len(resp.Buckets))
+	for _, b := range resp.Buckets {
+		var periodStart time.Time
+		if err := periodStart.UnmarshalBinary(b.PeriodStart); err != nil {
+			slog.Error("order.client.decode.failed", slog.String("field", "PeriodStart"), slog.String("error", err.Error()))
+			return nil, err
+		}
+		buckets = append(buckets, &domain.RevenueBucket{
+			PeriodStart: periodStart,
+			Revenue:     b.Revenue,
+			OrderCount:  b.OrderCount,
+		})
+	}
+	return buckets, nil
+}
+
+func (g *gRPCReportingClient) GetTopProducts(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.ProductSales, error) {
+	from, to := marshalRange(query)
+	resp, err := g.client.GetTopProducts(ctx, &proto.TopProductsRequest{
+		From:   from,
+		To:     to,
+		Limit:  query.Limit,
+		RankBy: query.RankBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*domain.ProductSales, 0, len(resp.Products))
+	for _, p := range resp.Products {
+		products = append(products, &domain.ProductSales{
+			CatalogId: p.CatalogId,
+			Quantity:  p.Quantity,
+			Revenue:   p.Revenue,
+		})
+	}
+	return products, nil
+}
+
+func (g *gRPCReportingClient) GetSalesSummary(ctx context.Context, query *dto.SalesReportQuery) (*domain.SalesSummary, error) {
+	from, to := marshalRange(query)
+	resp, err := g.client.GetSalesSummary(ctx, &proto.SalesRangeRequest{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.SalesSummary{
+		Revenue:           resp.Revenue,
+		OrderCount:        resp.OrderCount,
+		AverageOrderValue: resp.AverageOrderValue,
+	}, nil
+}
+
+func (g *gRPCReportingClient) GetCustomerBreakdown(ctx context.Context, query *dto.SalesReportQuery) (*domain.CustomerBreakdown, error) {
+	from, to := marshalRange(query)
+	resp, err := g.client.GetCustomerBreakdown(ctx, &proto.SalesRangeRequest{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CustomerBreakdown{
+		NewCustomers:       resp.NewCustomers,
+		ReturningCustomers: resp.ReturningCustomers,
+	}, nil
+}
+
+func (g *gRPCReportingClient) Close() error {
+	return g.conn.Close()
+}
+
+func marshalRange(query *dto.SalesReportQuery) ([]byte, []byte) {
+	from, _ := query.From.MarshalBinary()
+	to, _ := query.To.MarshalBinary()
+	return from, to
+}
+
+var reportingReads = []string{"GetRevenue", "GetTopProducts", "GetSalesSummary", "GetCustomerBreakdown"}
+
+func NewGRPCReportingClient(addr string, creds *mtls.Credentials, retry grpcx.RetryPolicy, breaker *grpcx.Breaker, dialOpts ...grpc.DialOption) (GRPCReportingClient, error) {
+	opts := []grpc.DialOption{
+		creds.DialOption(),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(), postgres.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor()),
+	}
+	opts = append(opts, grpcx.DialOptions()...)
+	opts = append(opts, grpcx.ResilienceOptions(proto.Reporting_ServiceDesc.ServiceName, reportingReads, retry, breaker)...)
+	opts = append(opts, dialOpts...)
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	reportingClient := proto.NewReportingClient(conn)
+	return &gRPCReportingClient{
+		conn:   conn,
+		client: reportingClient,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package orderHandler
+
+import (
+	"context"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/service"
+)
+
+// gRPCReportingServer serves the Reporting service. It is registered on the
+// order server's listener by gRPCOrderServer.Serve.
+type gRPCReportingServer struct {
+	reportService service.ReportService
+	proto.UnimplementedReportingServer
+}
+
+func (g *gRPCReportingServer) GetRevenue(ctx context.Context, req *proto.RevenueRequest) (*proto.RevenueResponse, error) {
+	query, err := toReportQuery(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	query.Granularity = req.Granularity
+
+	buckets, err := g.reportService.GetRevenue(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketsProto := make([]*proto.RevenueBucket, 0, len(buckets))
+	for _, b := range buckets {
+		bucketProto := &proto.RevenueBucket{
+			Revenue:    b.Revenue,
+			OrderCount: b.OrderCount,
+		}
+		bucketProto.PeriodStart, _ = b.PeriodStart.MarshalBinary()
+		bucketsProto = append(bucketsProto, bucketProto)
+	}
+	return &proto.RevenueResponse{
+		Buckets: bucketsProto,
+	}, nil
+}
+
+func (g *gRPCReportingServer) GetTopProducts(ctx context.Context, req *proto.TopProductsRequest) (*proto.TopProductsResponse, error) {
+	query, err := toReportQuery(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit = req.Limit
+	query.RankBy = req.RankBy
+
+	products, err := g.reportService.GetTopProducts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	productsProto := make([]*proto.ProductSales, 0, len(products))
+	for _, p := range products {
+		productsProto = append(productsProto, &proto.ProductSales{
+			CatalogId: p.CatalogId,
+			Quantity:  p.Quantity,
+			Revenue:   p.Revenue,
+		})
+	}
+	return &proto.TopProductsResponse{
+		Products: productsProto,
+	}, nil
+}
+
+func (g *gRPCReportingServer) GetSalesSummary(ctx context.Context, req *proto.SalesRangeRequest) (*proto.SalesSummaryResponse, error) {
+	query, err := toReportQuery(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := g.reportService.GetSalesSummary(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.SalesSummaryResponse{
+		Revenue:           summary.Revenue,
+		OrderCount:        summary.OrderCount,
+		AverageOrderValue: summary.AverageOrderValue,
+	}, nil
+}
+
+func (g *gRPCReportingServer) GetCustomerBreakdown(ctx context.Context, req *proto.SalesRangeRequest) (*proto.CustomerBreakdownResponse, error) {
+	query, err := toReportQuery(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown, err := g.reportService.GetCustomerBreakdown(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CustomerBreakdownResponse{
+		NewCustomers:       breakdown.NewCustomers,
+		ReturningCustomers: breakdown.ReturningCustomers,
+	}, nil
+}
+
+func toReportQuery(from, to []byte) (*dto.SalesReportQuery, error) {
+	query := &dto.SalesReportQuery{}
+	if len(from) > 0 {
+		if err := query.From.UnmarshalBinary(from); err != nil {
+			return nil, fmt.Errorf("%w: from: %v", errInvalidTimestamp, err)
+		}
+	}
+	if len(to) > 0 {
+		if err := query.To.UnmarshalBinary(to); err != nil {
+			return nil, fmt.Errorf("%w: to: %v", errInvalidTimestamp, err)
+		}
+	}
+	return query, nil
+}
@@ -5,27 +5,64 @@ import (
 	"errors"
 	"fmt"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	catalogDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
 	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/events"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/service"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"net"
 )
 
 type GRPCOrderServer interface {
 	CreateOrder(ctx context.Context, req *proto.CreateOrderRequest) (*proto.CreateOrderResponse, error)
+	GetOrdersByIds(ctx context.Context, req *proto.GetOrdersByIdsRequest) (*proto.GetOrdersByIdsResponse, error)
 	GetOrdersForAccount(ctx context.Context, req *proto.GetOrdersForAccountRequest) (*proto.GetOrdersForAccountResponse, error)
+	CancelOrder(ctx context.Context, req *proto.CancelOrderRequest) (*proto.CancelOrderResponse, error)
+	RequestReturn(ctx context.Context, req *proto.RequestReturnRequest) (*proto.RequestReturnResponse, error)
+	ApproveReturn(ctx context.Context, req *proto.ReviewReturnRequest) (*proto.ReturnResponse, error)
+	RejectReturn(ctx context.Context, req *proto.ReviewReturnRequest) (*proto.ReturnResponse, error)
+	ReceiveReturn(ctx context.Context, req *proto.ReviewReturnRequest) (*proto.ReturnResponse, error)
+	GetReturnsForOrder(ctx context.Context, req *proto.GetReturnsForOrderRequest) (*proto.GetReturnsForOrderResponse, error)
+	CreateShipment(ctx context.Context, req *proto.CreateShipmentRequest) (*proto.ShipmentResponse, error)
+	UpdateShipmentStatus(ctx context.Context, req *proto.UpdateShipmentStatusRequest) (*proto.ShipmentResponse, error)
+	GetShipmentsForOrder(ctx context.Context, req *proto.GetShipmentsForOrderRequest) (*proto.GetShipmentsForOrderResponse, error)
+	GetInvoice(ctx context.Context, req *proto.GetInvoiceRequest) (*proto.GetInvoiceResponse, error)
+	GetInvoiceForOrder(ctx context.Context, req *proto.GetInvoiceForOrderRequest) (*proto.GetInvoiceForOrderResponse, error)
+	WatchOrders(req *proto.WatchOrdersRequest, stream grpc.ServerStreamingServer[proto.OrderEvent]) error
+	PayOrder(ctx context.Context, req *proto.PayOrderRequest) (*proto.PayOrderResponse, error)
+	RefundOrder(ctx context.Context, req *proto.RefundOrderRequest) (*proto.RefundOrderResponse, error)
+	HandlePaymentWebhook(ctx context.Context, req *proto.HandlePaymentWebhookRequest) (*proto.HandlePaymentWebhookResponse, error)
 	Serve(addr string) error
+	ServeListener(lis net.Listener) error
 	Stop() error
 }
 
 type gRPCOrderServer struct {
-	orderService  service.OrderService
-	accountClient accountHandler.GRPCAccountClient
-	catalogClient catalogHandler.GRPCCatalogClient
-	server        *grpc.Server
+	orderService    service.OrderService
+	paymentService  service.PaymentService
+	returnService   service.ReturnService
+	shipmentService service.ShipmentService
+	reportService   service.ReportService
+	invoiceService  service.InvoiceService
+	broadcaster     events.Broadcaster
+	accountClient   accountHandler.GRPCAccountClient
+	catalogClient   catalogHandler.GRPCCatalogClient
+	checker         *health.Checker
+	reflection      bool
+	creds           *mtls.Credentials
+	server          *grpc.Server
 	proto.UnimplementedOrderServiceServer
 }
 
@@ -33,24 +70,31 @@ func (g *gRPCOrderServer) CreateOrder(ctx context.Context, req *proto.CreateOrde
 	// 1. Verify account exists
 	_, err := g.accountClient.GetAccountById(ctx, req.AccountId)
 	if err != nil {
-		return nil, fmt.Errorf("account not found")
+		return nil, fmt.Errorf("could not get account: %w", err)
 	}
 
-	// 2. Collect catalog IDs
+	// 2. Snapshot the shipping address, looking it up in the address book when
+	// only its id is given
+	shippingAddress, err := g.shippingAddress(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Collect catalog IDs
 	var catalogIds []string
 	for _, c := range req.Catalogs {
 		catalogIds = append(catalogIds, c.CatalogId)
 	}
 
-	// 3. Fetch catalog details from Catalog service
+	// 4. Fetch catalog details from Catalog service
 	catalogsFromService, err := g.catalogClient.GetCatalogs(ctx, &dto.CatalogQuery{
 		Ids: catalogIds,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch catalog details: %v", err)
+		return nil, fmt.Errorf("failed to fetch catalog details: %w", err)
 	}
 
-	// 4. Build ordered catalogs with quantity
+	// 5. Build ordered catalogs with quantity
 	var orderedCatalogs []*orderDTO.OrderedCatalog
 	for _, catalog := range catalogsFromService {
 		var quantity uint32
@@ -72,47 +116,342 @@ func (g *gRPCOrderServer) CreateOrder(ctx context.Context, req *proto.CreateOrde
 		})
 	}
 
-	// 5. Create order
+	// 6. Create order
 	order, err := g.orderService.CreateOrder(ctx, &orderDTO.Order{
+		AccountId:       req.AccountId,
+		ShippingAddress: shippingAddress,
+		CheckoutKey:     req.CheckoutKey,
+		Catalogs:        orderedCatalogs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create order: %w", err)
+	}
+
+	// 7. Convert to gRPC Order response
+	return &proto.CreateOrderResponse{
+		Order: toProtoOrder(order),
+	}, nil
+}
+
+func (g *gRPCOrderServer) GetOrdersByIds(ctx context.Context, req *proto.GetOrdersByIdsRequest) (*proto.GetOrdersByIdsResponse, error) {
+	domainOrders, err := g.orderService.GetOrdersByIds(ctx, req.Ids)
+	if err != nil {
+		return nil, fmt.Errorf("could not get orders: %w", err)
+	}
+
+	orders, err := g.toProtoOrders(ctx, domainOrders)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetOrdersByIdsResponse{
+		Orders: orders,
+	}, nil
+}
+
+func (g *gRPCOrderServer) GetOrdersForAccount(ctx context.Context, req *proto.GetOrdersForAccountRequest) (*proto.GetOrdersForAccountResponse, error) {
+	query := &orderDTO.OrderQuery{
 		AccountId: req.AccountId,
-		Catalogs:  orderedCatalogs,
+		Statuses:  req.Statuses,
+		MinTotal:  req.MinTotal,
+		MaxTotal:  req.MaxTotal,
+		Sort:      req.Sort,
+		Limit:     req.Limit,
+		Cursor:    req.Cursor,
+	}
+	if len(req.CreatedFrom) > 0 {
+		if err := query.CreatedFrom.UnmarshalBinary(req.CreatedFrom); err != nil {
+			return nil, fmt.Errorf("%w: createdFrom: %v", errInvalidTimestamp, err)
+		}
+	}
+	if len(req.CreatedTo) > 0 {
+		if err := query.CreatedTo.UnmarshalBinary(req.CreatedTo); err != nil {
+			return nil, fmt.Errorf("%w: createdTo: %v", errInvalidTimestamp, err)
+		}
+	}
+
+	accountOrders, nextCursor, err := g.orderService.GetOrdersForAccount(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("could not get orders: %w", err)
+	}
+
+	orders, err := g.toProtoOrders(ctx, accountOrders)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetOrdersForAccountResponse{
+		Orders:     orders,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (g *gRPCOrderServer) PayOrder(ctx context.Context, req *proto.PayOrderRequest) (*proto.PayOrderResponse, error) {
+	payment, err := g.paymentService.PayOrder(ctx, &orderDTO.PayOrder{
+		OrderId:       req.OrderId,
+		PaymentMethod: req.PaymentMethod,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not create order: %v", err)
+		return nil, err
 	}
+	return &proto.PayOrderResponse{
+		Payment: toProtoPayment(payment),
+	}, nil
+}
 
-	// 6. Convert to gRPC Order response
-	orderProto := &proto.Order{
-		Id:         order.Id,
-		AccountId:  order.AccountId,
-		TotalPrice: order.TotalPrice,
-		Catalogs:   []*proto.Order_OrderCatalog{},
+func (g *gRPCOrderServer) RefundOrder(ctx context.Context, req *proto.RefundOrderRequest) (*proto.RefundOrderResponse, error) {
+	payment, err := g.paymentService.RefundOrder(ctx, &orderDTO.RefundOrder{
+		OrderId: req.OrderId,
+		Amount:  req.Amount,
+	})
+	if err != nil {
+		return nil, err
 	}
-	orderProto.CreatedAt, _ = order.CreatedAt.MarshalBinary()
+	return &proto.RefundOrderResponse{
+		Payment: toProtoPayment(payment),
+	}, nil
+}
 
-	for _, c := range order.Catalogs {
-		orderProto.Catalogs = append(orderProto.Catalogs, &proto.Order_OrderCatalog{
-			Id:          c.Id,
-			Name:        c.Name,
-			Description: c.Description,
-			Price:       c.Price,
-			Quantity:    c.Quantity,
+func (g *gRPCOrderServer) HandlePaymentWebhook(ctx context.Context, req *proto.HandlePaymentWebhookRequest) (*proto.HandlePaymentWebhookResponse, error) {
+	if err := g.paymentService.HandleWebhook(ctx, &orderDTO.PaymentWebhook{
+		Provider:  req.Provider,
+		Payload:   req.Payload,
+		Signature: req.Signature,
+	}); err != nil {
+		return nil, err
+	}
+	return &proto.HandlePaymentWebhookResponse{}, nil
+}
+
+func (g *gRPCOrderServer) CancelOrder(ctx context.Context, req *proto.CancelOrderRequest) (*proto.CancelOrderResponse, error) {
+	order, err := g.orderService.CancelOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := g.toProtoOrders(ctx, []*domain.Order{order})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CancelOrderResponse{
+		Order: orders[0],
+	}, nil
+}
+
+func (g *gRPCOrderServer) RequestReturn(ctx context.Context, req *proto.RequestReturnRequest) (*proto.RequestReturnResponse, error) {
+	lines := make([]*orderDTO.ReturnLine, 0, len(req.Lines))
+	for _, l := range req.Lines {
+		lines = append(lines, &orderDTO.ReturnLine{
+			CatalogId: l.CatalogId,
+			Quantity:  l.Quantity,
 		})
 	}
 
-	return &proto.CreateOrderResponse{
-		Order: orderProto,
+	returns, err := g.returnService.RequestReturn(ctx, &orderDTO.RequestReturn{
+		OrderId: req.OrderId,
+		Reason:  req.Reason,
+		Lines:   lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RequestReturnResponse{
+		Returns: toProtoReturns(returns),
 	}, nil
 }
 
-func (g *gRPCOrderServer) GetOrdersForAccount(ctx context.Context, req *proto.GetOrdersForAccountRequest) (*proto.GetOrdersForAccountResponse, error) {
-	accountOrders, err := g.orderService.GetOrdersForAccount(ctx, req.AccountId)
+func (g *gRPCOrderServer) ApproveReturn(ctx context.Context, req *proto.ReviewReturnRequest) (*proto.ReturnResponse, error) {
+	ret, err := g.returnService.ApproveReturn(ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ReturnResponse{
+		Return: toProtoReturn(ret),
+	}, nil
+}
+
+func (g *gRPCOrderServer) RejectReturn(ctx context.Context, req *proto.ReviewReturnRequest) (*proto.ReturnResponse, error) {
+	ret, err := g.returnService.RejectReturn(ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ReturnResponse{
+		Return: toProtoReturn(ret),
+	}, nil
+}
+
+func (g *gRPCOrderServer) ReceiveReturn(ctx context.Context, req *proto.ReviewReturnRequest) (*proto.ReturnResponse, error) {
+	ret, err := g.returnService.ReceiveReturn(ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ReturnResponse{
+		Return: toProtoReturn(ret),
+	}, nil
+}
+
+func (g *gRPCOrderServer) GetReturnsForOrder(ctx context.Context, req *proto.GetReturnsForOrderRequest) (*proto.GetReturnsForOrderResponse, error) {
+	returns, err := g.returnService.GetReturnsForOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetReturnsForOrderResponse{
+		Returns: toProtoReturns(returns),
+	}, nil
+}
+
+func (g *gRPCOrderServer) CreateShipment(ctx context.Context, req *proto.CreateShipmentRequest) (*proto.ShipmentResponse, error) {
+	lines := make([]*orderDTO.ShipmentLine, 0, len(req.Lines))
+	for _, l := range req.Lines {
+		lines = append(lines, &orderDTO.ShipmentLine{
+			CatalogId: l.CatalogId,
+			Quantity:  l.Quantity,
+		})
+	}
+
+	shipment, err := g.shipmentService.CreateShipment(ctx, &orderDTO.CreateShipment{
+		OrderId:        req.OrderId,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		Lines:          lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ShipmentResponse{
+		Shipment: toProtoShipment(shipment),
+	}, nil
+}
+
+func (g *gRPCOrderServer) UpdateShipmentStatus(ctx context.Context, req *proto.UpdateShipmentStatusRequest) (*proto.ShipmentResponse, error) {
+	shipment, err := g.shipmentService.UpdateShipmentStatus(ctx, &orderDTO.UpdateShipmentStatus{
+		ShipmentId:  req.ShipmentId,
+		Status:      req.Status,
+		Description: req.Description,
+		Location:    req.Location,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ShipmentResponse{
+		Shipment: toProtoShipment(shipment),
+	}, nil
+}
+
+func (g *gRPCOrderServer) GetShipmentsForOrder(ctx context.Context, req *proto.GetShipmentsForOrderRequest) (*proto.GetShipmentsForOrderResponse, error) {
+	shipments, err := g.shipmentService.GetShipmentsForOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentsProto := make([]*proto.Shipment, 0, len(shipments))
+	for _, shipment := range shipments {
+		shipmentsProto = append(shipmentsProto, toProtoShipment(shipment))
+	}
+	return &proto.GetShipmentsForOrderResponse{
+		Shipments: shipmentsProto,
+	}, nil
+}
+
+func (g *gRPCOrderServer) GetInvoice(ctx context.Context, req *proto.GetInvoiceRequest) (*proto.GetInvoiceResponse, error) {
+	document, err := g.invoiceService.GetInvoice(ctx, &orderDTO.GetInvoice{
+		InvoiceId: req.InvoiceId,
+		AccountId: req.AccountId,
+		Format:    req.Format,
+	})
 	if err != nil {
-		return nil, errors.New("could not get orders")
+		return nil, err
 	}
+	return &proto.GetInvoiceResponse{
+		Invoice:     toProtoInvoice(document.Invoice),
+		Content:     document.Content,
+		ContentType: document.ContentType,
+	}, nil
+}
 
+// GetInvoiceForOrder responds without an invoice while the order is unpaid.
+func (g *gRPCOrderServer) GetInvoiceForOrder(ctx context.Context, req *proto.GetInvoiceForOrderRequest) (*proto.GetInvoiceForOrderResponse, error) {
+	invoice, err := g.invoiceService.GetInvoiceForOrder(ctx, req.OrderId)
+	if errors.Is(err, service.ErrOrderNotInvoiceable) {
+		return &proto.GetInvoiceForOrderResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetInvoiceForOrderResponse{
+		Invoice: toProtoInvoice(invoice),
+	}, nil
+}
+
+// WatchOrders streams the changes of one order, or of all orders of one
+// account, until the client goes away.
+func (g *gRPCOrderServer) WatchOrders(req *proto.WatchOrdersRequest, stream grpc.ServerStreamingServer[proto.OrderEvent]) error {
+	if req.OrderId == "" && req.AccountId == "" {
+		return errMissingWatchFilter
+	}
+
+	updates, unsubscribe := g.broadcaster.Subscribe(events.Filter{Tenant: tenant.FromContext(stream.Context()), OrderId: req.OrderId, AccountId: req.AccountId})
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-updates:
+			eventProto := &proto.OrderEvent{Order: toProtoOrder(event.Order)}
+			eventProto.OccurredAt, _ = event.OccurredAt.MarshalBinary()
+			if err := stream.Send(eventProto); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// shippingAddress returns the address to snapshot on a new order. An address
+// book entry takes precedence over an inline address and must belong to the
+// ordering account.
+func (g *gRPCOrderServer) shippingAddress(ctx context.Context, req *proto.CreateOrderRequest) (*orderDTO.ShippingAddress, error) {
+	if req.ShippingAddressId != "" {
+		address, err := g.accountClient.GetAddress(ctx, req.ShippingAddressId)
+		if err != nil {
+			return nil, fmt.Errorf("could not get shipping address: %w", err)
+		}
+		if address.AccountId != req.AccountId {
+			return nil, errShippingAddressNotFound
+		}
+		return &orderDTO.ShippingAddress{
+			Recipient:  address.Recipient,
+			Line1:      address.Line1,
+			Line2:      address.Line2,
+			City:       address.City,
+			Region:     address.Region,
+			PostalCode: address.PostalCode,
+			Country:    address.Country,
+			Phone:      address.Phone,
+		}, nil
+	}
+
+	if a := req.ShippingAddress; a != nil {
+		return &orderDTO.ShippingAddress{
+			Recipient:  a.Recipient,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+		}, nil
+	}
+	return nil, nil
+}
+
+// catalogIdBatch is the most ids the catalog service looks up at once.
+const catalogIdBatch = 50
+
+// toProtoOrders fills in catalog names and descriptions, and prices of
+// lines stored before prices were recorded, from the catalog service.
+func (g *gRPCOrderServer) toProtoOrders(ctx context.Context, domainOrders []*domain.Order) ([]*proto.Order, error) {
 	catalogIdMap := make(map[string]bool)
-	for _, o := range accountOrders {
+	for _, o := range domainOrders {
 		for _, rc := range o.Catalogs {
 			catalogIdMap[rc.Id] = true
 		}
@@ -123,24 +462,29 @@ func (g *gRPCOrderServer) GetOrdersForAccount(ctx context.Context, req *proto.Ge
 		catalogsIDs = append(catalogsIDs, id)
 	}
 
-	catalogs, err := g.catalogClient.GetCatalogs(ctx, &dto.CatalogQuery{
-		Limit:  0,
-		Offset: 0,
-		Query:  "",
-		Ids:    catalogsIDs,
-	})
-
-	if err != nil {
-		return nil, errors.New("could not get catalogs")
+	var catalogs []*catalogDomain.Catalog
+	for start := 0; start < len(catalogsIDs); start += catalogIdBatch {
+		batch, err := g.catalogClient.GetCatalogs(ctx, &dto.CatalogQuery{
+			Limit:  0,
+			Offset: 0,
+			Query:  "",
+			Ids:    catalogsIDs[start:min(start+catalogIdBatch, len(catalogsIDs))],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get catalogs: %w", err)
+		}
+		catalogs = append(catalogs, batch...)
 	}
 
 	var orders []*proto.Order
-	for _, o := range accountOrders {
+	for _, o := range domainOrders {
 		op := &proto.Order{
-			AccountId:  o.AccountId,
-			Id:         o.Id,
-			TotalPrice: o.TotalPrice,
-			Catalogs:   []*proto.Order_OrderCatalog{},
+			AccountId:       o.AccountId,
+			Id:              o.Id,
+			TotalPrice:      o.TotalPrice,
+			Status:          o.Status,
+			ShippingAddress: toProtoShippingAddress(o.ShippingAddress),
+			Catalogs:        []*proto.Order_OrderCatalog{},
 		}
 		op.CreatedAt, _ = o.CreatedAt.MarshalBinary()
 
@@ -149,7 +493,9 @@ func (g *gRPCOrderServer) GetOrdersForAccount(ctx context.Context, req *proto.Ge
 				if c.Id == catalog.Id {
 					catalog.Name = c.Name
 					catalog.Description = c.Description
-					catalog.Price = c.Price
+					if catalog.Price == 0 {
+						catalog.Price = c.Price
+					}
 					break
 				}
 			}
@@ -163,9 +509,7 @@ func (g *gRPCOrderServer) GetOrdersForAccount(ctx context.Context, req *proto.Ge
 		}
 		orders = append(orders, op)
 	}
-	return &proto.GetOrdersForAccountResponse{
-		Orders: orders,
-	}, nil
+	return orders, nil
 }
 
 func (g *gRPCOrderServer) Serve(addr string) error {
@@ -173,22 +517,188 @@ func (g *gRPCOrderServer) Serve(addr string) error {
 	if err != nil {
 		return err
 	}
-	g.server = grpc.NewServer()
+	return g.ServeListener(lis)
+}
+
+// ServeListener serves on lis until the server stops, closing it then.
+func (g *gRPCOrderServer) ServeListener(lis net.Listener) error {
+	opts := g.creds.ServerOptions()
+	opts = append(opts,
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), postgres.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), postgres.StreamServerInterceptor()),
+	)
+	opts = append(opts, grpcx.ServerOptions(errorMapper)...)
+	g.server = grpc.NewServer(opts...)
 	proto.RegisterOrderServiceServer(g.server, g)
+	proto.RegisterReportingServer(g.server, &gRPCReportingServer{reportService: g.reportService})
+	g.checker.Register(g.server)
+	metrics.InitializeServer(g.server)
+	if g.reflection {
+		reflection.Register(g.server)
+	}
 	return g.server.Serve(lis)
 }
 
 func (g *gRPCOrderServer) Stop() error {
+	g.checker.Shutdown()
 	if g.server != nil {
 		g.server.GracefulStop()
 	}
 	return nil
 }
 
-func NewGRPCOrderServer(orderService service.OrderService, accountClient accountHandler.GRPCAccountClient, catalogClient catalogHandler.GRPCCatalogClient) GRPCOrderServer {
+func toProtoPayment(payment *domain.Payment) *proto.Payment {
+	paymentProto := &proto.Payment{
+		Id:                payment.Id,
+		OrderId:           payment.OrderId,
+		Provider:          payment.Provider,
+		ProviderReference: payment.ProviderReference,
+		Amount:            payment.Amount,
+		RefundedAmount:    payment.RefundedAmount,
+		Status:            payment.Status,
+	}
+	paymentProto.CreatedAt, _ = payment.CreatedAt.MarshalBinary()
+	return paymentProto
+}
+
+func toProtoOrder(order *domain.Order) *proto.Order {
+	orderProto := &proto.Order{
+		Id:              order.Id,
+		AccountId:       order.AccountId,
+		TotalPrice:      order.TotalPrice,
+		Status:          order.Status,
+		ShippingAddress: toProtoShippingAddress(order.ShippingAddress),
+		Catalogs:        []*proto.Order_OrderCatalog{},
+	}
+	orderProto.CreatedAt, _ = order.CreatedAt.MarshalBinary()
+
+	for _, c := range order.Catalogs {
+		orderProto.Catalogs = append(orderProto.Catalogs, &proto.Order_OrderCatalog{
+			Id:          c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.Price,
+			Quantity:    c.Quantity,
+		})
+	}
+	return orderProto
+}
+
+func toProtoShippingAddress(address *domain.ShippingAddress) *proto.ShippingAddress {
+	if address == nil {
+		return nil
+	}
+	return &proto.ShippingAddress{
+		Recipient:  address.Recipient,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+		Phone:      address.Phone,
+	}
+}
+
+func toProtoShipment(shipment *domain.Shipment) *proto.Shipment {
+	shipmentProto := &proto.Shipment{
+		Id:             shipment.Id,
+		OrderId:        shipment.OrderId,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         shipment.Status,
+		Lines:          make([]*proto.Shipment_ShipmentLine, 0, len(shipment.Lines)),
+		Events:         make([]*proto.Shipment_ShipmentEvent, 0, len(shipment.Events)),
+	}
+	shipmentProto.CreatedAt, _ = shipment.CreatedAt.MarshalBinary()
+	shipmentProto.UpdatedAt, _ = shipment.UpdatedAt.MarshalBinary()
+
+	for _, line := range shipment.Lines {
+		shipmentProto.Lines = append(shipmentProto.Lines, &proto.Shipment_ShipmentLine{
+			CatalogId: line.CatalogId,
+			Quantity:  line.Quantity,
+		})
+	}
+	for _, event := range shipment.Events {
+		eventProto := &proto.Shipment_ShipmentEvent{
+			Status:      event.Status,
+			Description: event.Description,
+			Location:    event.Location,
+		}
+		eventProto.OccurredAt, _ = event.OccurredAt.MarshalBinary()
+		shipmentProto.Events = append(shipmentProto.Events, eventProto)
+	}
+	return shipmentProto
+}
+
+func toProtoInvoice(invoice *domain.Invoice) *proto.Invoice {
+	invoiceProto := &proto.Invoice{
+		Id:             invoice.Id,
+		OrderId:        invoice.OrderId,
+		AccountId:      invoice.AccountId,
+		Number:         invoice.Number,
+		SellerName:     invoice.SellerName,
+		BillingAddress: toProtoShippingAddress(invoice.BillingAddress),
+		Lines:          make([]*proto.InvoiceLine, 0, len(invoice.Lines)),
+		Subtotal:       invoice.Subtotal,
+		TaxRate:        invoice.TaxRate,
+		Tax:            invoice.Tax,
+		Total:          invoice.Total,
+	}
+	invoiceProto.IssuedAt, _ = invoice.IssuedAt.MarshalBinary()
+
+	for _, line := range invoice.Lines {
+		invoiceProto.Lines = append(invoiceProto.Lines, &proto.InvoiceLine{
+			CatalogId: line.CatalogId,
+			Name:      line.Name,
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+			Net:       line.Net,
+			Tax:       line.Tax,
+			Total:     line.Total,
+		})
+	}
+	return invoiceProto
+}
+
+func toProtoReturn(ret *domain.OrderReturn) *proto.OrderReturn {
+	returnProto := &proto.OrderReturn{
+		Id:                ret.Id,
+		OrderId:           ret.OrderId,
+		CatalogId:         ret.CatalogId,
+		Quantity:          ret.Quantity,
+		RestockedQuantity: ret.RestockedQuantity,
+		Reason:            ret.Reason,
+		Status:            ret.Status,
+		RefundAmount:      ret.RefundAmount,
+	}
+	returnProto.CreatedAt, _ = ret.CreatedAt.MarshalBinary()
+	returnProto.UpdatedAt, _ = ret.UpdatedAt.MarshalBinary()
+	return returnProto
+}
+
+func toProtoReturns(returns []*domain.OrderReturn) []*proto.OrderReturn {
+	returnsProto := make([]*proto.OrderReturn, 0, len(returns))
+	for _, ret := range returns {
+		returnsProto = append(returnsProto, toProtoReturn(ret))
+	}
+	return returnsProto
+}
+
+func NewGRPCOrderServer(orderService service.OrderService, paymentService service.PaymentService, returnService service.ReturnService, shipmentService service.ShipmentService, reportService service.ReportService, invoiceService service.InvoiceService, broadcaster events.Broadcaster, accountClient accountHandler.GRPCAccountClient, catalogClient catalogHandler.GRPCCatalogClient, checker *health.Checker, reflection bool, creds *mtls.Credentials) GRPCOrderServer {
 	return &gRPCOrderServer{
-		orderService:  orderService,
-		accountClient: accountClient,
-		catalogClient: catalogClient,
+		orderService:    orderService,
+		paymentService:  paymentService,
+		returnService:   returnService,
+		shipmentService: shipmentService,
+		reportService:   reportService,
+		invoiceService:  invoiceService,
+		broadcaster:     broadcaster,
+		accountClient:   accountClient,
+		catalogClient:   catalogClient,
+		checker:         checker,
+		reflection:      reflection,
+		creds:           creds,
 	}
 }
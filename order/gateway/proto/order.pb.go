@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,14 +23,16 @@ const (
 )
 
 type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     []byte                 `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	AccountId     string                 `protobuf:"bytes,3,opt,name=accountId,proto3" json:"accountId,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
-	Catalogs      []*Order_OrderCatalog  `protobuf:"bytes,5,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       []byte                 `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	AccountId       string                 `protobuf:"bytes,3,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	TotalPrice      float64                `protobuf:"fixed64,4,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Catalogs        []*Order_OrderCatalog  `protobuf:"bytes,5,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ShippingAddress *ShippingAddress       `protobuf:"bytes,7,opt,name=shippingAddress,proto3" json:"shippingAddress,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -97,28 +100,48 @@ func (x *Order) GetCatalogs() []*Order_OrderCatalog {
 	return nil
 }
 
-type CreateOrderRequest struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	AccountId     string                             `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
-	Catalogs      []*CreateOrderRequest_OrderCatalog `protobuf:"bytes,4,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+type ShippingAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1         string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,6,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Phone         string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
+func (x *ShippingAddress) Reset() {
+	*x = ShippingAddress{}
 	mi := &file_gateway_proto_order_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderRequest) String() string {
+func (x *ShippingAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
+func (*ShippingAddress) ProtoMessage() {}
 
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *ShippingAddress) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_order_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -130,47 +153,2081 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShippingAddress.ProtoReflect.Descriptor instead.
+func (*ShippingAddress) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_order_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateOrderRequest) GetAccountId() string {
+func (x *ShippingAddress) GetRecipient() string {
 	if x != nil {
-		return x.AccountId
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetCatalogs() []*CreateOrderRequest_OrderCatalog {
+func (x *ShippingAddress) GetLine1() string {
 	if x != nil {
-		return x.Catalogs
+		return x.Line1
 	}
-	return nil
+	return ""
 }
 
-type CreateOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ShippingAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
+func (x *ShippingAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type Shipment struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Id             string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                    `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Carrier        string                    `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                    `protobuf:"bytes,4,opt,name=trackingNumber,proto3" json:"trackingNumber,omitempty"`
+	Status         string                    `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Lines          []*Shipment_ShipmentLine  `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`
+	Events         []*Shipment_ShipmentEvent `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	CreatedAt      []byte                    `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      []byte                    `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_gateway_proto_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Shipment) GetLines() []*Shipment_ShipmentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Shipment) GetEvents() []*Shipment_ShipmentEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Shipment) GetCreatedAt() []byte {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetUpdatedAt() []byte {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Payment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId           string                 `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Provider          string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,4,opt,name=providerReference,proto3" json:"providerReference,omitempty"`
+	Amount            float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	RefundedAmount    float64                `protobuf:"fixed64,6,opt,name=refundedAmount,proto3" json:"refundedAmount,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt         []byte                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_gateway_proto_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Payment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Payment) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetCreatedAt() []byte {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OrderReturn struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId           string                 `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	CatalogId         string                 `protobuf:"bytes,3,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity          uint32                 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RestockedQuantity uint32                 `protobuf:"varint,5,opt,name=restockedQuantity,proto3" json:"restockedQuantity,omitempty"`
+	Reason            string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	RefundAmount      float64                `protobuf:"fixed64,8,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`
+	CreatedAt         []byte                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt         []byte                 `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrderReturn) Reset() {
+	*x = OrderReturn{}
+	mi := &file_gateway_proto_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderReturn) ProtoMessage() {}
+
+func (x *OrderReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderReturn.ProtoReflect.Descriptor instead.
+func (*OrderReturn) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderReturn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetRestockedQuantity() uint32 {
+	if x != nil {
+		return x.RestockedQuantity
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetRefundAmount() float64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetCreatedAt() []byte {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetUpdatedAt() []byte {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateOrderRequest struct {
+	state             protoimpl.MessageState             `protogen:"open.v1"`
+	AccountId         string                             `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Catalogs          []*CreateOrderRequest_OrderCatalog `protobuf:"bytes,4,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	ShippingAddress   *ShippingAddress                   `protobuf:"bytes,5,opt,name=shippingAddress,proto3" json:"shippingAddress,omitempty"`
+	ShippingAddressId string                             `protobuf:"bytes,6,opt,name=shippingAddressId,proto3" json:"shippingAddressId,omitempty"`
+	// checkoutKey, when set, makes the call idempotent: an order already
+	// placed with it is returned instead of a new one.
+	CheckoutKey   string `protobuf:"bytes,7,opt,name=checkoutKey,proto3" json:"checkoutKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateOrderRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCatalogs() []*CreateOrderRequest_OrderCatalog {
+	if x != nil {
+		return x.Catalogs
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShippingAddressId() string {
+	if x != nil {
+		return x.ShippingAddressId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCheckoutKey() string {
+	if x != nil {
+		return x.CheckoutKey
+	}
+	return ""
+}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetOrdersByIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByIdsRequest) Reset() {
+	*x = GetOrdersByIdsRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByIdsRequest) ProtoMessage() {}
+
+func (x *GetOrdersByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOrdersByIdsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetOrdersByIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByIdsResponse) Reset() {
+	*x = GetOrdersByIdsResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByIdsResponse) ProtoMessage() {}
+
+func (x *GetOrdersByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetOrdersByIdsResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GetOrdersForAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	CreatedFrom   []byte                 `protobuf:"bytes,2,opt,name=createdFrom,proto3" json:"createdFrom,omitempty"`
+	CreatedTo     []byte                 `protobuf:"bytes,3,opt,name=createdTo,proto3" json:"createdTo,omitempty"`
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	MinTotal      *float64               `protobuf:"fixed64,5,opt,name=minTotal,proto3,oneof" json:"minTotal,omitempty"`
+	MaxTotal      *float64               `protobuf:"fixed64,6,opt,name=maxTotal,proto3,oneof" json:"maxTotal,omitempty"`
+	Sort          string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	Limit         uint64                 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersForAccountRequest) Reset() {
+	*x = GetOrdersForAccountRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersForAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersForAccountRequest) ProtoMessage() {}
+
+func (x *GetOrdersForAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersForAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersForAccountRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOrdersForAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetOrdersForAccountRequest) GetCreatedFrom() []byte {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetOrdersForAccountRequest) GetCreatedTo() []byte {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetOrdersForAccountRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetOrdersForAccountRequest) GetMinTotal() float64 {
+	if x != nil && x.MinTotal != nil {
+		return *x.MinTotal
+	}
+	return 0
+}
+
+func (x *GetOrdersForAccountRequest) GetMaxTotal() float64 {
+	if x != nil && x.MaxTotal != nil {
+		return *x.MaxTotal
+	}
+	return 0
+}
+
+func (x *GetOrdersForAccountRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetOrdersForAccountRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetOrdersForAccountRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetOrdersForAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersForAccountResponse) Reset() {
+	*x = GetOrdersForAccountResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersForAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersForAccountResponse) ProtoMessage() {}
+
+func (x *GetOrdersForAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersForAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersForAccountResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetOrdersForAccountResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersForAccountResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PayOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,2,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayOrderRequest) Reset() {
+	*x = PayOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderRequest) ProtoMessage() {}
+
+func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderRequest.ProtoReflect.Descriptor instead.
+func (*PayOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PayOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type PayOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayOrderResponse) Reset() {
+	*x = PayOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderResponse) ProtoMessage() {}
+
+func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderResponse.ProtoReflect.Descriptor instead.
+func (*PayOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PayOrderResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type RefundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderRequest) Reset() {
+	*x = RefundOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderRequest) ProtoMessage() {}
+
+func (x *RefundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RefundOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RefundOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderResponse) Reset() {
+	*x = RefundOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderResponse) ProtoMessage() {}
+
+func (x *RefundOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefundOrderResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type HandlePaymentWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentWebhookRequest) Reset() {
+	*x = HandlePaymentWebhookRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentWebhookRequest) ProtoMessage() {}
+
+func (x *HandlePaymentWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentWebhookRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HandlePaymentWebhookRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *HandlePaymentWebhookRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *HandlePaymentWebhookRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type HandlePaymentWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentWebhookResponse) Reset() {
+	*x = HandlePaymentWebhookResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentWebhookResponse) ProtoMessage() {}
+
+func (x *HandlePaymentWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentWebhookResponse.ProtoReflect.Descriptor instead.
+func (*HandlePaymentWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{18}
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	OrderId       string                             `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Reason        string                             `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Lines         []*RequestReturnRequest_ReturnLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RequestReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetLines() []*RequestReturnRequest_ReturnLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type RequestReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Returns       []*OrderReturn         `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnResponse) Reset() {
+	*x = RequestReturnResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnResponse) ProtoMessage() {}
+
+func (x *RequestReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnResponse.ProtoReflect.Descriptor instead.
+func (*RequestReturnResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RequestReturnResponse) GetReturns() []*OrderReturn {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+type ReviewReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReturnId      string                 `protobuf:"bytes,1,opt,name=returnId,proto3" json:"returnId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewReturnRequest) Reset() {
+	*x = ReviewReturnRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReturnRequest) ProtoMessage() {}
+
+func (x *ReviewReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReviewReturnRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReviewReturnRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+type ReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Return        *OrderReturn           `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnResponse) Reset() {
+	*x = ReturnResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnResponse) ProtoMessage() {}
+
+func (x *ReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReturnResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReturnResponse) GetReturn() *OrderReturn {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+type GetReturnsForOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnsForOrderRequest) Reset() {
+	*x = GetReturnsForOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnsForOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnsForOrderRequest) ProtoMessage() {}
+
+func (x *GetReturnsForOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnsForOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnsForOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReturnsForOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetReturnsForOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Returns       []*OrderReturn         `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnsForOrderResponse) Reset() {
+	*x = GetReturnsForOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnsForOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnsForOrderResponse) ProtoMessage() {}
+
+func (x *GetReturnsForOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnsForOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnsForOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetReturnsForOrderResponse) GetReturns() []*OrderReturn {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState                `protogen:"open.v1"`
+	OrderId        string                                `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Carrier        string                                `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                                `protobuf:"bytes,3,opt,name=trackingNumber,proto3" json:"trackingNumber,omitempty"`
+	Lines          []*CreateShipmentRequest_ShipmentLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetLines() []*CreateShipmentRequest_ShipmentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type UpdateShipmentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipmentId,proto3" json:"shipmentId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentStatusRequest) Reset() {
+	*x = UpdateShipmentStatusRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentStatusRequest) ProtoMessage() {}
+
+func (x *UpdateShipmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateShipmentStatusRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *UpdateShipmentStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateShipmentStatusRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateShipmentStatusRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type ShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentResponse) Reset() {
+	*x = ShipmentResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentResponse) ProtoMessage() {}
+
+func (x *ShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type GetShipmentsForOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentsForOrderRequest) Reset() {
+	*x = GetShipmentsForOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentsForOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentsForOrderRequest) ProtoMessage() {}
+
+func (x *GetShipmentsForOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentsForOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetShipmentsForOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetShipmentsForOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetShipmentsForOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentsForOrderResponse) Reset() {
+	*x = GetShipmentsForOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentsForOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentsForOrderResponse) ProtoMessage() {}
+
+func (x *GetShipmentsForOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentsForOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetShipmentsForOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetShipmentsForOrderResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type InvoiceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	Net           float64                `protobuf:"fixed64,5,opt,name=net,proto3" json:"net,omitempty"`
+	Tax           float64                `protobuf:"fixed64,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total         float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	mi := &file_gateway_proto_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InvoiceLine) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Invoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	AccountId      string                 `protobuf:"bytes,3,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Number         string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	SellerName     string                 `protobuf:"bytes,5,opt,name=sellerName,proto3" json:"sellerName,omitempty"`
+	BillingAddress *ShippingAddress       `protobuf:"bytes,6,opt,name=billingAddress,proto3" json:"billingAddress,omitempty"`
+	Lines          []*InvoiceLine         `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal       float64                `protobuf:"fixed64,8,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	TaxRate        float64                `protobuf:"fixed64,9,opt,name=taxRate,proto3" json:"taxRate,omitempty"`
+	Tax            float64                `protobuf:"fixed64,10,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total          float64                `protobuf:"fixed64,11,opt,name=total,proto3" json:"total,omitempty"`
+	IssuedAt       []byte                 `protobuf:"bytes,12,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_gateway_proto_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Invoice) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetSellerName() string {
+	if x != nil {
+		return x.SellerName
+	}
+	return ""
+}
+
+func (x *Invoice) GetBillingAddress() *ShippingAddress {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *Invoice) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Invoice) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Invoice) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *Invoice) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetIssuedAt() []byte {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetInvoiceRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *GetInvoiceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetInvoiceRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceResponse) Reset() {
+	*x = GetInvoiceResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *GetInvoiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*GetInvoiceResponse) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[2]
+func (x *GetInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,40 +2238,54 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_order_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateOrderResponse) GetOrder() *Order {
+func (x *GetInvoiceResponse) GetInvoice() *Invoice {
 	if x != nil {
-		return x.Order
+		return x.Invoice
 	}
 	return nil
 }
 
-type GetOrderRequest struct {
+func (x *GetInvoiceResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetInvoiceResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetInvoiceForOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_gateway_proto_order_proto_msgTypes[3]
+func (x *GetInvoiceForOrderRequest) Reset() {
+	*x = GetInvoiceForOrderRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *GetInvoiceForOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*GetInvoiceForOrderRequest) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[3]
+func (x *GetInvoiceForOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,40 +2296,40 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_order_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetInvoiceForOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceForOrderRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetOrderRequest) GetId() string {
+func (x *GetInvoiceForOrderRequest) GetOrderId() string {
 	if x != nil {
-		return x.Id
+		return x.OrderId
 	}
 	return ""
 }
 
-type GetOrderResponse struct {
+type GetInvoiceForOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_gateway_proto_order_proto_msgTypes[4]
+func (x *GetInvoiceForOrderResponse) Reset() {
+	*x = GetInvoiceForOrderResponse{}
+	mi := &file_gateway_proto_order_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *GetInvoiceForOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*GetInvoiceForOrderResponse) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[4]
+func (x *GetInvoiceForOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,40 +2340,41 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_order_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetInvoiceForOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceForOrderResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *GetInvoiceForOrderResponse) GetInvoice() *Invoice {
 	if x != nil {
-		return x.Order
+		return x.Invoice
 	}
 	return nil
 }
 
-type GetOrdersForAccountRequest struct {
+type WatchOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersForAccountRequest) Reset() {
-	*x = GetOrdersForAccountRequest{}
-	mi := &file_gateway_proto_order_proto_msgTypes[5]
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	mi := &file_gateway_proto_order_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersForAccountRequest) String() string {
+func (x *WatchOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersForAccountRequest) ProtoMessage() {}
+func (*WatchOrdersRequest) ProtoMessage() {}
 
-func (x *GetOrdersForAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[5]
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,40 +2385,48 @@ func (x *GetOrdersForAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersForAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersForAccountRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_order_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetOrdersForAccountRequest) GetAccountId() string {
+func (x *WatchOrdersRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *WatchOrdersRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-type GetOrdersForAccountResponse struct {
+type OrderEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	OccurredAt    []byte                 `protobuf:"bytes,2,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersForAccountResponse) Reset() {
-	*x = GetOrdersForAccountResponse{}
-	mi := &file_gateway_proto_order_proto_msgTypes[6]
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_gateway_proto_order_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersForAccountResponse) String() string {
+func (x *OrderEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersForAccountResponse) ProtoMessage() {}
+func (*OrderEvent) ProtoMessage() {}
 
-func (x *GetOrdersForAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[6]
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,14 +2437,21 @@ func (x *GetOrdersForAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersForAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersForAccountResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetOrdersForAccountResponse) GetOrders() []*Order {
+func (x *OrderEvent) GetOrder() *Order {
 	if x != nil {
-		return x.Orders
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOccurredAt() []byte {
+	if x != nil {
+		return x.OccurredAt
 	}
 	return nil
 }
@@ -382,7 +2469,7 @@ type Order_OrderCatalog struct {
 
 func (x *Order_OrderCatalog) Reset() {
 	*x = Order_OrderCatalog{}
-	mi := &file_gateway_proto_order_proto_msgTypes[7]
+	mi := &file_gateway_proto_order_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +2481,7 @@ func (x *Order_OrderCatalog) String() string {
 func (*Order_OrderCatalog) ProtoMessage() {}
 
 func (x *Order_OrderCatalog) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[7]
+	mi := &file_gateway_proto_order_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,6 +2532,126 @@ func (x *Order_OrderCatalog) GetQuantity() uint32 {
 	return 0
 }
 
+type Shipment_ShipmentLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment_ShipmentLine) Reset() {
+	*x = Shipment_ShipmentLine{}
+	mi := &file_gateway_proto_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment_ShipmentLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment_ShipmentLine) ProtoMessage() {}
+
+func (x *Shipment_ShipmentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment_ShipmentLine.ProtoReflect.Descriptor instead.
+func (*Shipment_ShipmentLine) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Shipment_ShipmentLine) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *Shipment_ShipmentLine) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Shipment_ShipmentEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	OccurredAt    []byte                 `protobuf:"bytes,4,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment_ShipmentEvent) Reset() {
+	*x = Shipment_ShipmentEvent{}
+	mi := &file_gateway_proto_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment_ShipmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment_ShipmentEvent) ProtoMessage() {}
+
+func (x *Shipment_ShipmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment_ShipmentEvent.ProtoReflect.Descriptor instead.
+func (*Shipment_ShipmentEvent) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Shipment_ShipmentEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Shipment_ShipmentEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Shipment_ShipmentEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Shipment_ShipmentEvent) GetOccurredAt() []byte {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
 type CreateOrderRequest_OrderCatalog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CatalogId     string                 `protobuf:"bytes,2,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
@@ -455,7 +2662,7 @@ type CreateOrderRequest_OrderCatalog struct {
 
 func (x *CreateOrderRequest_OrderCatalog) Reset() {
 	*x = CreateOrderRequest_OrderCatalog{}
-	mi := &file_gateway_proto_order_proto_msgTypes[8]
+	mi := &file_gateway_proto_order_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +2674,7 @@ func (x *CreateOrderRequest_OrderCatalog) String() string {
 func (*CreateOrderRequest_OrderCatalog) ProtoMessage() {}
 
 func (x *CreateOrderRequest_OrderCatalog) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_order_proto_msgTypes[8]
+	mi := &file_gateway_proto_order_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +2687,7 @@ func (x *CreateOrderRequest_OrderCatalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest_OrderCatalog.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest_OrderCatalog) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_order_proto_rawDescGZIP(), []int{1, 0}
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *CreateOrderRequest_OrderCatalog) GetCatalogId() string {
@@ -497,11 +2704,115 @@ func (x *CreateOrderRequest_OrderCatalog) GetQuantity() uint32 {
 	return 0
 }
 
+type RequestReturnRequest_ReturnLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest_ReturnLine) Reset() {
+	*x = RequestReturnRequest_ReturnLine{}
+	mi := &file_gateway_proto_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest_ReturnLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest_ReturnLine) ProtoMessage() {}
+
+func (x *RequestReturnRequest_ReturnLine) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest_ReturnLine.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest_ReturnLine) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *RequestReturnRequest_ReturnLine) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest_ReturnLine) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateShipmentRequest_ShipmentLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest_ShipmentLine) Reset() {
+	*x = CreateShipmentRequest_ShipmentLine{}
+	mi := &file_gateway_proto_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest_ShipmentLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest_ShipmentLine) ProtoMessage() {}
+
+func (x *CreateShipmentRequest_ShipmentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest_ShipmentLine.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest_ShipmentLine) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_order_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *CreateShipmentRequest_ShipmentLine) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest_ShipmentLine) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 var File_gateway_proto_order_proto protoreflect.FileDescriptor
 
 const file_gateway_proto_order_proto_rawDesc = "" +
 	"\n" +
-	"\x19gateway/proto/order.proto\x12\x05order\"\xb3\x02\n" +
+	"\x19gateway/proto/order.proto\x12\x05order\x1a\x1cgoogle/api/annotations.proto\"\x8d\x03\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tcreatedAt\x18\x02 \x01(\fR\tcreatedAt\x12\x1c\n" +
@@ -509,16 +2820,73 @@ const file_gateway_proto_order_proto_rawDesc = "" +
 	"\n" +
 	"totalPrice\x18\x04 \x01(\x01R\n" +
 	"totalPrice\x125\n" +
-	"\bcatalogs\x18\x05 \x03(\v2\x19.order.Order.OrderCatalogR\bcatalogs\x1a\x86\x01\n" +
+	"\bcatalogs\x18\x05 \x03(\v2\x19.order.Order.OrderCatalogR\bcatalogs\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12@\n" +
+	"\x0fshippingAddress\x18\a \x01(\v2\x16.order.ShippingAddressR\x0fshippingAddress\x1a\x86\x01\n" +
 	"\fOrderCatalog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\rR\bquantity\"\xc0\x01\n" +
+	"\bquantity\x18\x05 \x01(\rR\bquantity\"\xd7\x01\n" +
+	"\x0fShippingAddress\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"postalCode\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\"\x87\x04\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aorderId\x18\x02 \x01(\tR\aorderId\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\tR\acarrier\x12&\n" +
+	"\x0etrackingNumber\x18\x04 \x01(\tR\x0etrackingNumber\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x122\n" +
+	"\x05lines\x18\x06 \x03(\v2\x1c.order.Shipment.ShipmentLineR\x05lines\x125\n" +
+	"\x06events\x18\a \x03(\v2\x1d.order.Shipment.ShipmentEventR\x06events\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\fR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\t \x01(\fR\tupdatedAt\x1aH\n" +
+	"\fShipmentLine\x12\x1c\n" +
+	"\tcatalogId\x18\x01 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\x1a\x85\x01\n" +
+	"\rShipmentEvent\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x1e\n" +
+	"\n" +
+	"occurredAt\x18\x04 \x01(\fR\n" +
+	"occurredAt\"\xf3\x01\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aorderId\x18\x02 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12,\n" +
+	"\x11providerReference\x18\x04 \x01(\tR\x11providerReference\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12&\n" +
+	"\x0erefundedAmount\x18\x06 \x01(\x01R\x0erefundedAmount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\fR\tcreatedAt\"\xaf\x02\n" +
+	"\vOrderReturn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aorderId\x18\x02 \x01(\tR\aorderId\x12\x1c\n" +
+	"\tcatalogId\x18\x03 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\rR\bquantity\x12,\n" +
+	"\x11restockedQuantity\x18\x05 \x01(\rR\x11restockedQuantity\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\"\n" +
+	"\frefundAmount\x18\b \x01(\x01R\frefundAmount\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\fR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\fR\tupdatedAt\"\xd2\x02\n" +
 	"\x12CreateOrderRequest\x12\x1c\n" +
 	"\taccountId\x18\x02 \x01(\tR\taccountId\x12B\n" +
-	"\bcatalogs\x18\x04 \x03(\v2&.order.CreateOrderRequest.OrderCatalogR\bcatalogs\x1aH\n" +
+	"\bcatalogs\x18\x04 \x03(\v2&.order.CreateOrderRequest.OrderCatalogR\bcatalogs\x12@\n" +
+	"\x0fshippingAddress\x18\x05 \x01(\v2\x16.order.ShippingAddressR\x0fshippingAddress\x12,\n" +
+	"\x11shippingAddressId\x18\x06 \x01(\tR\x11shippingAddressId\x12 \n" +
+	"\vcheckoutKey\x18\a \x01(\tR\vcheckoutKey\x1aH\n" +
 	"\fOrderCatalog\x12\x1c\n" +
 	"\tcatalogId\x18\x02 \x01(\tR\tcatalogId\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\rR\bquantity\"9\n" +
@@ -527,14 +2895,153 @@ const file_gateway_proto_order_proto_rawDesc = "" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
 	"\x10GetOrderResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\":\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\")\n" +
+	"\x15GetOrdersByIdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\">\n" +
+	"\x16GetOrdersByIdsResponse\x12$\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\"\xb4\x02\n" +
 	"\x1aGetOrdersForAccountRequest\x12\x1c\n" +
-	"\taccountId\x18\x01 \x01(\tR\taccountId\"C\n" +
+	"\taccountId\x18\x01 \x01(\tR\taccountId\x12 \n" +
+	"\vcreatedFrom\x18\x02 \x01(\fR\vcreatedFrom\x12\x1c\n" +
+	"\tcreatedTo\x18\x03 \x01(\fR\tcreatedTo\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\x12\x1f\n" +
+	"\bminTotal\x18\x05 \x01(\x01H\x00R\bminTotal\x88\x01\x01\x12\x1f\n" +
+	"\bmaxTotal\x18\x06 \x01(\x01H\x01R\bmaxTotal\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x04R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursorB\v\n" +
+	"\t_minTotalB\v\n" +
+	"\t_maxTotal\"c\n" +
 	"\x1bGetOrdersForAccountResponse\x12$\n" +
-	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders2\xb6\x01\n" +
-	"\fOrderService\x12F\n" +
-	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\"\x00\x12^\n" +
-	"\x13GetOrdersForAccount\x12!.order.GetOrdersForAccountRequest\x1a\".order.GetOrdersForAccountResponse\"\x00B\x0fZ\rgateway/protob\x06proto3"
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"Q\n" +
+	"\x0fPayOrderRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12$\n" +
+	"\rpaymentMethod\x18\x02 \x01(\tR\rpaymentMethod\"<\n" +
+	"\x10PayOrderResponse\x12(\n" +
+	"\apayment\x18\x01 \x01(\v2\x0e.order.PaymentR\apayment\"F\n" +
+	"\x12RefundOrderRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"?\n" +
+	"\x13RefundOrderResponse\x12(\n" +
+	"\apayment\x18\x01 \x01(\v2\x0e.order.PaymentR\apayment\"q\n" +
+	"\x1bHandlePaymentWebhookRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\"\x1e\n" +
+	"\x1cHandlePaymentWebhookResponse\".\n" +
+	"\x12CancelOrderRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\"9\n" +
+	"\x13CancelOrderResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\xce\x01\n" +
+	"\x14RequestReturnRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12<\n" +
+	"\x05lines\x18\x03 \x03(\v2&.order.RequestReturnRequest.ReturnLineR\x05lines\x1aF\n" +
+	"\n" +
+	"ReturnLine\x12\x1c\n" +
+	"\tcatalogId\x18\x01 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"E\n" +
+	"\x15RequestReturnResponse\x12,\n" +
+	"\areturns\x18\x01 \x03(\v2\x12.order.OrderReturnR\areturns\"1\n" +
+	"\x13ReviewReturnRequest\x12\x1a\n" +
+	"\breturnId\x18\x01 \x01(\tR\breturnId\"<\n" +
+	"\x0eReturnResponse\x12*\n" +
+	"\x06return\x18\x01 \x01(\v2\x12.order.OrderReturnR\x06return\"5\n" +
+	"\x19GetReturnsForOrderRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\"J\n" +
+	"\x1aGetReturnsForOrderResponse\x12,\n" +
+	"\areturns\x18\x01 \x03(\v2\x12.order.OrderReturnR\areturns\"\xfe\x01\n" +
+	"\x15CreateShipmentRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12&\n" +
+	"\x0etrackingNumber\x18\x03 \x01(\tR\x0etrackingNumber\x12?\n" +
+	"\x05lines\x18\x04 \x03(\v2).order.CreateShipmentRequest.ShipmentLineR\x05lines\x1aH\n" +
+	"\fShipmentLine\x12\x1c\n" +
+	"\tcatalogId\x18\x01 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"\x93\x01\n" +
+	"\x1bUpdateShipmentStatusRequest\x12\x1e\n" +
+	"\n" +
+	"shipmentId\x18\x01 \x01(\tR\n" +
+	"shipmentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\"?\n" +
+	"\x10ShipmentResponse\x12+\n" +
+	"\bshipment\x18\x01 \x01(\v2\x0f.order.ShipmentR\bshipment\"7\n" +
+	"\x1bGetShipmentsForOrderRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\"M\n" +
+	"\x1cGetShipmentsForOrderResponse\x12-\n" +
+	"\tshipments\x18\x01 \x03(\v2\x0f.order.ShipmentR\tshipments\"\xb3\x01\n" +
+	"\vInvoiceLine\x12\x1c\n" +
+	"\tcatalogId\x18\x01 \x01(\tR\tcatalogId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\x12\x1c\n" +
+	"\tunitPrice\x18\x04 \x01(\x01R\tunitPrice\x12\x10\n" +
+	"\x03net\x18\x05 \x01(\x01R\x03net\x12\x10\n" +
+	"\x03tax\x18\x06 \x01(\x01R\x03tax\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total\"\xed\x02\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aorderId\x18\x02 \x01(\tR\aorderId\x12\x1c\n" +
+	"\taccountId\x18\x03 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\tR\x06number\x12\x1e\n" +
+	"\n" +
+	"sellerName\x18\x05 \x01(\tR\n" +
+	"sellerName\x12>\n" +
+	"\x0ebillingAddress\x18\x06 \x01(\v2\x16.order.ShippingAddressR\x0ebillingAddress\x12(\n" +
+	"\x05lines\x18\a \x03(\v2\x12.order.InvoiceLineR\x05lines\x12\x1a\n" +
+	"\bsubtotal\x18\b \x01(\x01R\bsubtotal\x12\x18\n" +
+	"\ataxRate\x18\t \x01(\x01R\ataxRate\x12\x10\n" +
+	"\x03tax\x18\n" +
+	" \x01(\x01R\x03tax\x12\x14\n" +
+	"\x05total\x18\v \x01(\x01R\x05total\x12\x1a\n" +
+	"\bissuedAt\x18\f \x01(\fR\bissuedAt\"g\n" +
+	"\x11GetInvoiceRequest\x12\x1c\n" +
+	"\tinvoiceId\x18\x01 \x01(\tR\tinvoiceId\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"z\n" +
+	"\x12GetInvoiceResponse\x12(\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x0e.order.InvoiceR\ainvoice\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12 \n" +
+	"\vcontentType\x18\x03 \x01(\tR\vcontentType\"5\n" +
+	"\x19GetInvoiceForOrderRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\"F\n" +
+	"\x1aGetInvoiceForOrderResponse\x12(\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x0e.order.InvoiceR\ainvoice\"L\n" +
+	"\x12WatchOrdersRequest\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\"P\n" +
+	"\n" +
+	"OrderEvent\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12\x1e\n" +
+	"\n" +
+	"occurredAt\x18\x02 \x01(\fR\n" +
+	"occurredAt2\xb5\x0f\n" +
+	"\fOrderService\x12[\n" +
+	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/orders\x12a\n" +
+	"\x0eGetOrdersByIds\x12\x1c.order.GetOrdersByIdsRequest\x1a\x1d.order.GetOrdersByIdsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/orders\x12\x85\x01\n" +
+	"\x13GetOrdersForAccount\x12!.order.GetOrdersForAccountRequest\x1a\".order.GetOrdersForAccountResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/accounts/{accountId}/orders\x12`\n" +
+	"\bPayOrder\x12\x16.order.PayOrderRequest\x1a\x17.order.PayOrderResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/orders/{orderId}/pay\x12l\n" +
+	"\vRefundOrder\x12\x19.order.RefundOrderRequest\x1a\x1a.order.RefundOrderResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/orders/{orderId}/refund\x12a\n" +
+	"\x14HandlePaymentWebhook\x12\".order.HandlePaymentWebhookRequest\x1a#.order.HandlePaymentWebhookResponse\"\x00\x12i\n" +
+	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x1b/v1/orders/{orderId}/cancel\x12s\n" +
+	"\rRequestReturn\x12\x1b.order.RequestReturnRequest\x1a\x1c.order.RequestReturnResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/orders/{orderId}/returns\x12j\n" +
+	"\rApproveReturn\x12\x1a.order.ReviewReturnRequest\x1a\x15.order.ReturnResponse\"&\x82\xd3\xe4\x93\x02 \"\x1e/v1/returns/{returnId}/approve\x12h\n" +
+	"\fRejectReturn\x12\x1a.order.ReviewReturnRequest\x1a\x15.order.ReturnResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1d/v1/returns/{returnId}/reject\x12j\n" +
+	"\rReceiveReturn\x12\x1a.order.ReviewReturnRequest\x1a\x15.order.ReturnResponse\"&\x82\xd3\xe4\x93\x02 \"\x1e/v1/returns/{returnId}/receive\x12\x7f\n" +
+	"\x12GetReturnsForOrder\x12 .order.GetReturnsForOrderRequest\x1a!.order.GetReturnsForOrderResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/orders/{orderId}/returns\x12r\n" +
+	"\x0eCreateShipment\x12\x1c.order.CreateShipmentRequest\x1a\x17.order.ShipmentResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/orders/{orderId}/shipments\x12\x81\x01\n" +
+	"\x14UpdateShipmentStatus\x12\".order.UpdateShipmentStatusRequest\x1a\x17.order.ShipmentResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/shipments/{shipmentId}/status\x12\x87\x01\n" +
+	"\x14GetShipmentsForOrder\x12\".order.GetShipmentsForOrderRequest\x1a#.order.GetShipmentsForOrderResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/orders/{orderId}/shipments\x12C\n" +
+	"\n" +
+	"GetInvoice\x12\x18.order.GetInvoiceRequest\x1a\x19.order.GetInvoiceResponse\"\x00\x12\x7f\n" +
+	"\x12GetInvoiceForOrder\x12 .order.GetInvoiceForOrderRequest\x1a!.order.GetInvoiceForOrderResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/orders/{orderId}/invoice\x12?\n" +
+	"\vWatchOrders\x12\x19.order.WatchOrdersRequest\x1a\x11.order.OrderEvent\"\x000\x01B\x0fZ\rgateway/protob\x06proto3"
 
 var (
 	file_gateway_proto_order_proto_rawDescOnce sync.Once
@@ -548,33 +3055,122 @@ func file_gateway_proto_order_proto_rawDescGZIP() []byte {
 	return file_gateway_proto_order_proto_rawDescData
 }
 
-var file_gateway_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gateway_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_gateway_proto_order_proto_goTypes = []any{
-	(*Order)(nil),                           // 0: order.Order
-	(*CreateOrderRequest)(nil),              // 1: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),             // 2: order.CreateOrderResponse
-	(*GetOrderRequest)(nil),                 // 3: order.GetOrderRequest
-	(*GetOrderResponse)(nil),                // 4: order.GetOrderResponse
-	(*GetOrdersForAccountRequest)(nil),      // 5: order.GetOrdersForAccountRequest
-	(*GetOrdersForAccountResponse)(nil),     // 6: order.GetOrdersForAccountResponse
-	(*Order_OrderCatalog)(nil),              // 7: order.Order.OrderCatalog
-	(*CreateOrderRequest_OrderCatalog)(nil), // 8: order.CreateOrderRequest.OrderCatalog
+	(*Order)(nil),                              // 0: order.Order
+	(*ShippingAddress)(nil),                    // 1: order.ShippingAddress
+	(*Shipment)(nil),                           // 2: order.Shipment
+	(*Payment)(nil),                            // 3: order.Payment
+	(*OrderReturn)(nil),                        // 4: order.OrderReturn
+	(*CreateOrderRequest)(nil),                 // 5: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),                // 6: order.CreateOrderResponse
+	(*GetOrderRequest)(nil),                    // 7: order.GetOrderRequest
+	(*GetOrderResponse)(nil),                   // 8: order.GetOrderResponse
+	(*GetOrdersByIdsRequest)(nil),              // 9: order.GetOrdersByIdsRequest
+	(*GetOrdersByIdsResponse)(nil),             // 10: order.GetOrdersByIdsResponse
+	(*GetOrdersForAccountRequest)(nil),         // 11: order.GetOrdersForAccountRequest
+	(*GetOrdersForAccountResponse)(nil),        // 12: order.GetOrdersForAccountResponse
+	(*PayOrderRequest)(nil),                    // 13: order.PayOrderRequest
+	(*PayOrderResponse)(nil),                   // 14: order.PayOrderResponse
+	(*RefundOrderRequest)(nil),                 // 15: order.RefundOrderRequest
+	(*RefundOrderResponse)(nil),                // 16: order.RefundOrderResponse
+	(*HandlePaymentWebhookRequest)(nil),        // 17: order.HandlePaymentWebhookRequest
+	(*HandlePaymentWebhookResponse)(nil),       // 18: order.HandlePaymentWebhookResponse
+	(*CancelOrderRequest)(nil),                 // 19: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                // 20: order.CancelOrderResponse
+	(*RequestReturnRequest)(nil),               // 21: order.RequestReturnRequest
+	(*RequestReturnResponse)(nil),              // 22: order.RequestReturnResponse
+	(*ReviewReturnRequest)(nil),                // 23: order.ReviewReturnRequest
+	(*ReturnResponse)(nil),                     // 24: order.ReturnResponse
+	(*GetReturnsForOrderRequest)(nil),          // 25: order.GetReturnsForOrderRequest
+	(*GetReturnsForOrderResponse)(nil),         // 26: order.GetReturnsForOrderResponse
+	(*CreateShipmentRequest)(nil),              // 27: order.CreateShipmentRequest
+	(*UpdateShipmentStatusRequest)(nil),        // 28: order.UpdateShipmentStatusRequest
+	(*ShipmentResponse)(nil),                   // 29: order.ShipmentResponse
+	(*GetShipmentsForOrderRequest)(nil),        // 30: order.GetShipmentsForOrderRequest
+	(*GetShipmentsForOrderResponse)(nil),       // 31: order.GetShipmentsForOrderResponse
+	(*InvoiceLine)(nil),                        // 32: order.InvoiceLine
+	(*Invoice)(nil),                            // 33: order.Invoice
+	(*GetInvoiceRequest)(nil),                  // 34: order.GetInvoiceRequest
+	(*GetInvoiceResponse)(nil),                 // 35: order.GetInvoiceResponse
+	(*GetInvoiceForOrderRequest)(nil),          // 36: order.GetInvoiceForOrderRequest
+	(*GetInvoiceForOrderResponse)(nil),         // 37: order.GetInvoiceForOrderResponse
+	(*WatchOrdersRequest)(nil),                 // 38: order.WatchOrdersRequest
+	(*OrderEvent)(nil),                         // 39: order.OrderEvent
+	(*Order_OrderCatalog)(nil),                 // 40: order.Order.OrderCatalog
+	(*Shipment_ShipmentLine)(nil),              // 41: order.Shipment.ShipmentLine
+	(*Shipment_ShipmentEvent)(nil),             // 42: order.Shipment.ShipmentEvent
+	(*CreateOrderRequest_OrderCatalog)(nil),    // 43: order.CreateOrderRequest.OrderCatalog
+	(*RequestReturnRequest_ReturnLine)(nil),    // 44: order.RequestReturnRequest.ReturnLine
+	(*CreateShipmentRequest_ShipmentLine)(nil), // 45: order.CreateShipmentRequest.ShipmentLine
 }
 var file_gateway_proto_order_proto_depIdxs = []int32{
-	7, // 0: order.Order.catalogs:type_name -> order.Order.OrderCatalog
-	8, // 1: order.CreateOrderRequest.catalogs:type_name -> order.CreateOrderRequest.OrderCatalog
-	0, // 2: order.CreateOrderResponse.order:type_name -> order.Order
-	0, // 3: order.GetOrderResponse.order:type_name -> order.Order
-	0, // 4: order.GetOrdersForAccountResponse.orders:type_name -> order.Order
-	1, // 5: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	5, // 6: order.OrderService.GetOrdersForAccount:input_type -> order.GetOrdersForAccountRequest
-	2, // 7: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	6, // 8: order.OrderService.GetOrdersForAccount:output_type -> order.GetOrdersForAccountResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	40, // 0: order.Order.catalogs:type_name -> order.Order.OrderCatalog
+	1,  // 1: order.Order.shippingAddress:type_name -> order.ShippingAddress
+	41, // 2: order.Shipment.lines:type_name -> order.Shipment.ShipmentLine
+	42, // 3: order.Shipment.events:type_name -> order.Shipment.ShipmentEvent
+	43, // 4: order.CreateOrderRequest.catalogs:type_name -> order.CreateOrderRequest.OrderCatalog
+	1,  // 5: order.CreateOrderRequest.shippingAddress:type_name -> order.ShippingAddress
+	0,  // 6: order.CreateOrderResponse.order:type_name -> order.Order
+	0,  // 7: order.GetOrderResponse.order:type_name -> order.Order
+	0,  // 8: order.GetOrdersByIdsResponse.orders:type_name -> order.Order
+	0,  // 9: order.GetOrdersForAccountResponse.orders:type_name -> order.Order
+	3,  // 10: order.PayOrderResponse.payment:type_name -> order.Payment
+	3,  // 11: order.RefundOrderResponse.payment:type_name -> order.Payment
+	0,  // 12: order.CancelOrderResponse.order:type_name -> order.Order
+	44, // 13: order.RequestReturnRequest.lines:type_name -> order.RequestReturnRequest.ReturnLine
+	4,  // 14: order.RequestReturnResponse.returns:type_name -> order.OrderReturn
+	4,  // 15: order.ReturnResponse.return:type_name -> order.OrderReturn
+	4,  // 16: order.GetReturnsForOrderResponse.returns:type_name -> order.OrderReturn
+	45, // 17: order.CreateShipmentRequest.lines:type_name -> order.CreateShipmentRequest.ShipmentLine
+	2,  // 18: order.ShipmentResponse.shipment:type_name -> order.Shipment
+	2,  // 19: order.GetShipmentsForOrderResponse.shipments:type_name -> order.Shipment
+	1,  // 20: order.Invoice.billingAddress:type_name -> order.ShippingAddress
+	32, // 21: order.Invoice.lines:type_name -> order.InvoiceLine
+	33, // 22: order.GetInvoiceResponse.invoice:type_name -> order.Invoice
+	33, // 23: order.GetInvoiceForOrderResponse.invoice:type_name -> order.Invoice
+	0,  // 24: order.OrderEvent.order:type_name -> order.Order
+	5,  // 25: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	9,  // 26: order.OrderService.GetOrdersByIds:input_type -> order.GetOrdersByIdsRequest
+	11, // 27: order.OrderService.GetOrdersForAccount:input_type -> order.GetOrdersForAccountRequest
+	13, // 28: order.OrderService.PayOrder:input_type -> order.PayOrderRequest
+	15, // 29: order.OrderService.RefundOrder:input_type -> order.RefundOrderRequest
+	17, // 30: order.OrderService.HandlePaymentWebhook:input_type -> order.HandlePaymentWebhookRequest
+	19, // 31: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	21, // 32: order.OrderService.RequestReturn:input_type -> order.RequestReturnRequest
+	23, // 33: order.OrderService.ApproveReturn:input_type -> order.ReviewReturnRequest
+	23, // 34: order.OrderService.RejectReturn:input_type -> order.ReviewReturnRequest
+	23, // 35: order.OrderService.ReceiveReturn:input_type -> order.ReviewReturnRequest
+	25, // 36: order.OrderService.GetReturnsForOrder:input_type -> order.GetReturnsForOrderRequest
+	27, // 37: order.OrderService.CreateShipment:input_type -> order.CreateShipmentRequest
+	28, // 38: order.OrderService.UpdateShipmentStatus:input_type -> order.UpdateShipmentStatusRequest
+	30, // 39: order.OrderService.GetShipmentsForOrder:input_type -> order.GetShipmentsForOrderRequest
+	34, // 40: order.OrderService.GetInvoice:input_type -> order.GetInvoiceRequest
+	36, // 41: order.OrderService.GetInvoiceForOrder:input_type -> order.GetInvoiceForOrderRequest
+	38, // 42: order.OrderService.WatchOrders:input_type -> order.WatchOrdersRequest
+	6,  // 43: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	10, // 44: order.OrderService.GetOrdersByIds:output_type -> order.GetOrdersByIdsResponse
+	12, // 45: order.OrderService.GetOrdersForAccount:output_type -> order.GetOrdersForAccountResponse
+	14, // 46: order.OrderService.PayOrder:output_type -> order.PayOrderResponse
+	16, // 47: order.OrderService.RefundOrder:output_type -> order.RefundOrderResponse
+	18, // 48: order.OrderService.HandlePaymentWebhook:output_type -> order.HandlePaymentWebhookResponse
+	20, // 49: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	22, // 50: order.OrderService.RequestReturn:output_type -> order.RequestReturnResponse
+	24, // 51: order.OrderService.ApproveReturn:output_type -> order.ReturnResponse
+	24, // 52: order.OrderService.RejectReturn:output_type -> order.ReturnResponse
+	24, // 53: order.OrderService.ReceiveReturn:output_type -> order.ReturnResponse
+	26, // 54: order.OrderService.GetReturnsForOrder:output_type -> order.GetReturnsForOrderResponse
+	29, // 55: order.OrderService.CreateShipment:output_type -> order.ShipmentResponse
+	29, // 56: order.OrderService.UpdateShipmentStatus:output_type -> order.ShipmentResponse
+	31, // 57: order.OrderService.GetShipmentsForOrder:output_type -> order.GetShipmentsForOrderResponse
+	35, // 58: order.OrderService.GetInvoice:output_type -> order.GetInvoiceResponse
+	37, // 59: order.OrderService.GetInvoiceForOrder:output_type -> order.GetInvoiceForOrderResponse
+	39, // 60: order.OrderService.WatchOrders:output_type -> order.OrderEvent
+	43, // [43:61] is the sub-list for method output_type
+	25, // [25:43] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_gateway_proto_order_proto_init() }
@@ -582,13 +3178,14 @@ func file_gateway_proto_order_proto_init() {
 	if File_gateway_proto_order_proto != nil {
 		return
 	}
+	file_gateway_proto_order_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_proto_order_proto_rawDesc), len(file_gateway_proto_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,635 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v3.21.12
+// source: gateway/proto/reporting.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RevenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []byte                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            []byte                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Granularity   string                 `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueRequest) Reset() {
+	*x = RevenueRequest{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueRequest) ProtoMessage() {}
+
+func (x *RevenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueRequest.ProtoReflect.Descriptor instead.
+func (*RevenueRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevenueRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RevenueRequest) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RevenueRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+type RevenueBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   []byte                 `protobuf:"bytes,1,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	OrderCount    uint64                 `protobuf:"varint,3,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueBucket) Reset() {
+	*x = RevenueBucket{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueBucket) ProtoMessage() {}
+
+func (x *RevenueBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueBucket.ProtoReflect.Descriptor instead.
+func (*RevenueBucket) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevenueBucket) GetPeriodStart() []byte {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetOrderCount() uint64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+type RevenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buckets       []*RevenueBucket       `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueResponse) Reset() {
+	*x = RevenueResponse{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueResponse) ProtoMessage() {}
+
+func (x *RevenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueResponse.ProtoReflect.Descriptor instead.
+func (*RevenueResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevenueResponse) GetBuckets() []*RevenueBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type TopProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []byte                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            []byte                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	RankBy        string                 `protobuf:"bytes,4,opt,name=rankBy,proto3" json:"rankBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopProductsRequest) Reset() {
+	*x = TopProductsRequest{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopProductsRequest) ProtoMessage() {}
+
+func (x *TopProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopProductsRequest.ProtoReflect.Descriptor instead.
+func (*TopProductsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopProductsRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TopProductsRequest) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TopProductsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TopProductsRequest) GetRankBy() string {
+	if x != nil {
+		return x.RankBy
+	}
+	return ""
+}
+
+type ProductSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Quantity      uint64                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,3,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSales) Reset() {
+	*x = ProductSales{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSales) ProtoMessage() {}
+
+func (x *ProductSales) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSales.ProtoReflect.Descriptor instead.
+func (*ProductSales) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProductSales) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ProductSales) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductSales) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+type TopProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductSales        `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopProductsResponse) Reset() {
+	*x = TopProductsResponse{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopProductsResponse) ProtoMessage() {}
+
+func (x *TopProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopProductsResponse.ProtoReflect.Descriptor instead.
+func (*TopProductsResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TopProductsResponse) GetProducts() []*ProductSales {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type SalesRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []byte                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            []byte                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalesRangeRequest) Reset() {
+	*x = SalesRangeRequest{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesRangeRequest) ProtoMessage() {}
+
+func (x *SalesRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesRangeRequest.ProtoReflect.Descriptor instead.
+func (*SalesRangeRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SalesRangeRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SalesRangeRequest) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type SalesSummaryResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Revenue           float64                `protobuf:"fixed64,1,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	OrderCount        uint64                 `protobuf:"varint,2,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	AverageOrderValue float64                `protobuf:"fixed64,3,opt,name=averageOrderValue,proto3" json:"averageOrderValue,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SalesSummaryResponse) Reset() {
+	*x = SalesSummaryResponse{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesSummaryResponse) ProtoMessage() {}
+
+func (x *SalesSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesSummaryResponse.ProtoReflect.Descriptor instead.
+func (*SalesSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SalesSummaryResponse) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *SalesSummaryResponse) GetOrderCount() uint64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *SalesSummaryResponse) GetAverageOrderValue() float64 {
+	if x != nil {
+		return x.AverageOrderValue
+	}
+	return 0
+}
+
+type CustomerBreakdownResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	NewCustomers       uint64                 `protobuf:"varint,1,opt,name=newCustomers,proto3" json:"newCustomers,omitempty"`
+	ReturningCustomers uint64                 `protobuf:"varint,2,opt,name=returningCustomers,proto3" json:"returningCustomers,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CustomerBreakdownResponse) Reset() {
+	*x = CustomerBreakdownResponse{}
+	mi := &file_gateway_proto_reporting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerBreakdownResponse) ProtoMessage() {}
+
+func (x *CustomerBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_reporting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*CustomerBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_reporting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CustomerBreakdownResponse) GetNewCustomers() uint64 {
+	if x != nil {
+		return x.NewCustomers
+	}
+	return 0
+}
+
+func (x *CustomerBreakdownResponse) GetReturningCustomers() uint64 {
+	if x != nil {
+		return x.ReturningCustomers
+	}
+	return 0
+}
+
+var File_gateway_proto_reporting_proto protoreflect.FileDescriptor
+
+const file_gateway_proto_reporting_proto_rawDesc = "" +
+	"\n" +
+	"\x1dgateway/proto/reporting.proto\x12\x05order\"V\n" +
+	"\x0eRevenueRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\fR\x02to\x12 \n" +
+	"\vgranularity\x18\x03 \x01(\tR\vgranularity\"k\n" +
+	"\rRevenueBucket\x12 \n" +
+	"\vperiodStart\x18\x01 \x01(\fR\vperiodStart\x12\x18\n" +
+	"\arevenue\x18\x02 \x01(\x01R\arevenue\x12\x1e\n" +
+	"\n" +
+	"orderCount\x18\x03 \x01(\x04R\n" +
+	"orderCount\"A\n" +
+	"\x0fRevenueResponse\x12.\n" +
+	"\abuckets\x18\x01 \x03(\v2\x14.order.RevenueBucketR\abuckets\"f\n" +
+	"\x12TopProductsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\fR\x02to\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x04R\x05limit\x12\x16\n" +
+	"\x06rankBy\x18\x04 \x01(\tR\x06rankBy\"b\n" +
+	"\fProductSales\x12\x1c\n" +
+	"\tcatalogId\x18\x01 \x01(\tR\tcatalogId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x04R\bquantity\x12\x18\n" +
+	"\arevenue\x18\x03 \x01(\x01R\arevenue\"F\n" +
+	"\x13TopProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.order.ProductSalesR\bproducts\"7\n" +
+	"\x11SalesRangeRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\fR\x02to\"~\n" +
+	"\x14SalesSummaryResponse\x12\x18\n" +
+	"\arevenue\x18\x01 \x01(\x01R\arevenue\x12\x1e\n" +
+	"\n" +
+	"orderCount\x18\x02 \x01(\x04R\n" +
+	"orderCount\x12,\n" +
+	"\x11averageOrderValue\x18\x03 \x01(\x01R\x11averageOrderValue\"o\n" +
+	"\x19CustomerBreakdownResponse\x12\"\n" +
+	"\fnewCustomers\x18\x01 \x01(\x04R\fnewCustomers\x12.\n" +
+	"\x12returningCustomers\x18\x02 \x01(\x04R\x12returningCustomers2\xb7\x02\n" +
+	"\tReporting\x12=\n" +
+	"\n" +
+	"GetRevenue\x12\x15.order.RevenueRequest\x1a\x16.order.RevenueResponse\"\x00\x12I\n" +
+	"\x0eGetTopProducts\x12\x19.order.TopProductsRequest\x1a\x1a.order.TopProductsResponse\"\x00\x12J\n" +
+	"\x0fGetSalesSummary\x12\x18.order.SalesRangeRequest\x1a\x1b.order.SalesSummaryResponse\"\x00\x12T\n" +
+	"\x14GetCustomerBreakdown\x12\x18.order.SalesRangeRequest\x1a .order.CustomerBreakdownResponse\"\x00B\x0fZ\rgateway/protob\x06proto3"
+
+var (
+	file_gateway_proto_reporting_proto_rawDescOnce sync.Once
+	file_gateway_proto_reporting_proto_rawDescData []byte
+)
+
+func file_gateway_proto_reporting_proto_rawDescGZIP() []byte {
+	file_gateway_proto_reporting_proto_rawDescOnce.Do(func() {
+		file_gateway_proto_reporting_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gateway_proto_reporting_proto_rawDesc), len(file_gateway_proto_reporting_proto_rawDesc)))
+	})
+	return file_gateway_proto_reporting_proto_rawDescData
+}
+
+var file_gateway_proto_reporting_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gateway_proto_reporting_proto_goTypes = []any{
+	(*RevenueRequest)(nil),            // 0: order.RevenueRequest
+	(*RevenueBucket)(nil),             // 1: order.RevenueBucket
+	(*RevenueResponse)(nil),           // 2: order.RevenueResponse
+	(*TopProductsRequest)(nil),        // 3: order.TopProductsRequest
+	(*ProductSales)(nil),              // 4: order.ProductSales
+	(*TopProductsResponse)(nil),       // 5: order.TopProductsResponse
+	(*SalesRangeRequest)(nil),         // 6: order.SalesRangeRequest
+	(*SalesSummaryResponse)(nil),      // 7: order.SalesSummaryResponse
+	(*CustomerBreakdownResponse)(nil), // 8: order.CustomerBreakdownResponse
+}
+var file_gateway_proto_reporting_proto_depIdxs = []int32{
+	1, // 0: order.RevenueResponse.buckets:type_name -> order.RevenueBucket
+	4, // 1: order.TopProductsResponse.products:type_name -> order.ProductSales
+	0, // 2: order.Reporting.GetRevenue:input_type -> order.RevenueRequest
+	3, // 3: order.Reporting.GetTopProducts:input_type -> order.TopProductsRequest
+	6, // 4: order.Reporting.GetSalesSummary:input_type -> order.SalesRangeRequest
+	6, // 5: order.Reporting.GetCustomerBreakdown:input_type -> order.SalesRangeRequest
+	2, // 6: order.Reporting.GetRevenue:output_type -> order.RevenueResponse
+	5, // 7: order.Reporting.GetTopProducts:output_type -> order.TopProductsResponse
+	7, // 8: order.Reporting.GetSalesSummary:output_type -> order.SalesSummaryResponse
+	8, // 9: order.Reporting.GetCustomerBreakdown:output_type -> order.CustomerBreakdownResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_gateway_proto_reporting_proto_init() }
+func file_gateway_proto_reporting_proto_init() {
+	if File_gateway_proto_reporting_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_proto_reporting_proto_rawDesc), len(file_gateway_proto_reporting_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gateway_proto_reporting_proto_goTypes,
+		DependencyIndexes: file_gateway_proto_reporting_proto_depIdxs,
+		MessageInfos:      file_gateway_proto_reporting_proto_msgTypes,
+	}.Build()
+	File_gateway_proto_reporting_proto = out.File
+	file_gateway_proto_reporting_proto_goTypes = nil
+	file_gateway_proto_reporting_proto_depIdxs = nil
+}
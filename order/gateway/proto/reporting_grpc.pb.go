@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: gateway/proto/reporting.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Reporting_GetRevenue_FullMethodName           = "/order.Reporting/GetRevenue"
+	Reporting_GetTopProducts_FullMethodName       = "/order.Reporting/GetTopProducts"
+	Reporting_GetSalesSummary_FullMethodName      = "/order.Reporting/GetSalesSummary"
+	Reporting_GetCustomerBreakdown_FullMethodName = "/order.Reporting/GetCustomerBreakdown"
+)
+
+// ReportingClient is the client API for Reporting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReportingClient interface {
+	GetRevenue(ctx context.Context, in *RevenueRequest, opts ...grpc.CallOption) (*RevenueResponse, error)
+	GetTopProducts(ctx context.Context, in *TopProductsRequest, opts ...grpc.CallOption) (*TopProductsResponse, error)
+	GetSalesSummary(ctx context.Context, in *SalesRangeRequest, opts ...grpc.CallOption) (*SalesSummaryResponse, error)
+	GetCustomerBreakdown(ctx context.Context, in *SalesRangeRequest, opts ...grpc.CallOption) (*CustomerBreakdownResponse, error)
+}
+
+type reportingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportingClient(cc grpc.ClientConnInterface) ReportingClient {
+	return &reportingClient{cc}
+}
+
+func (c *reportingClient) GetRevenue(ctx context.Context, in *RevenueRequest, opts ...grpc.CallOption) (*RevenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevenueResponse)
+	err := c.cc.Invoke(ctx, Reporting_GetRevenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingClient) GetTopProducts(ctx context.Context, in *TopProductsRequest, opts ...grpc.CallOption) (*TopProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopProductsResponse)
+	err := c.cc.Invoke(ctx, Reporting_GetTopProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingClient) GetSalesSummary(ctx context.Context, in *SalesRangeRequest, opts ...grpc.CallOption) (*SalesSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalesSummaryResponse)
+	err := c.cc.Invoke(ctx, Reporting_GetSalesSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingClient) GetCustomerBreakdown(ctx context.Context, in *SalesRangeRequest, opts ...grpc.CallOption) (*CustomerBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerBreakdownResponse)
+	err := c.cc.Invoke(ctx, Reporting_GetCustomerBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportingServer is the server API for Reporting service.
+// All implementations must embed UnimplementedReportingServer
+// for forward compatibility.
+type ReportingServer interface {
+	GetRevenue(context.Context, *RevenueRequest) (*RevenueResponse, error)
+	GetTopProducts(context.Context, *TopProductsRequest) (*TopProductsResponse, error)
+	GetSalesSummary(context.Context, *SalesRangeRequest) (*SalesSummaryResponse, error)
+	GetCustomerBreakdown(context.Context, *SalesRangeRequest) (*CustomerBreakdownResponse, error)
+	mustEmbedUnimplementedReportingServer()
+}
+
+// UnimplementedReportingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportingServer struct{}
+
+func (UnimplementedReportingServer) GetRevenue(context.Context, *RevenueRequest) (*RevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenue not implemented")
+}
+func (UnimplementedReportingServer) GetTopProducts(context.Context, *TopProductsRequest) (*TopProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopProducts not implemented")
+}
+func (UnimplementedReportingServer) GetSalesSummary(context.Context, *SalesRangeRequest) (*SalesSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesSummary not implemented")
+}
+func (UnimplementedReportingServer) GetCustomerBreakdown(context.Context, *SalesRangeRequest) (*CustomerBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerBreakdown not implemented")
+}
+func (UnimplementedReportingServer) mustEmbedUnimplementedReportingServer() {}
+func (UnimplementedReportingServer) testEmbeddedByValue()                   {}
+
+// UnsafeReportingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportingServer will
+// result in compilation errors.
+type UnsafeReportingServer interface {
+	mustEmbedUnimplementedReportingServer()
+}
+
+func RegisterReportingServer(s grpc.ServiceRegistrar, srv ReportingServer) {
+	// If the following call pancis, it indicates UnimplementedReportingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Reporting_ServiceDesc, srv)
+}
+
+func _Reporting_GetRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServer).GetRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reporting_GetRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServer).GetRevenue(ctx, req.(*RevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reporting_GetTopProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServer).GetTopProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reporting_GetTopProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServer).GetTopProducts(ctx, req.(*TopProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reporting_GetSalesSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SalesRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServer).GetSalesSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reporting_GetSalesSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServer).GetSalesSummary(ctx, req.(*SalesRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reporting_GetCustomerBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SalesRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServer).GetCustomerBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reporting_GetCustomerBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServer).GetCustomerBreakdown(ctx, req.(*SalesRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reporting_ServiceDesc is the grpc.ServiceDesc for Reporting service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reporting_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order.Reporting",
+	HandlerType: (*ReportingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRevenue",
+			Handler:    _Reporting_GetRevenue_Handler,
+		},
+		{
+			MethodName: "GetTopProducts",
+			Handler:    _Reporting_GetTopProducts_Handler,
+		},
+		{
+			MethodName: "GetSalesSummary",
+			Handler:    _Reporting_GetSalesSummary_Handler,
+		},
+		{
+			MethodName: "GetCustomerBreakdown",
+			Handler:    _Reporting_GetCustomerBreakdown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gateway/proto/reporting.proto",
+}
@@ -0,0 +1,142 @@
+package invoice
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"github.com/go-pdf/fpdf"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"html/template"
+	"strings"
+)
+
+const (
+	FormatPDF  = "pdf"
+	FormatHTML = "html"
+)
+
+var ErrUnsupportedFormat = errors.New("invalid input: unsupported invoice format")
+
+//go:embed template.html
+var htmlTemplate string
+
+var funcs = template.FuncMap{
+	"money":   money,
+	"percent": percent,
+}
+
+// Renderer turns a frozen invoice into a downloadable document.
+type Renderer interface {
+	Render(invoice *domain.Invoice, format string) (*domain.InvoiceDocument, error)
+}
+
+type renderer struct {
+	html *template.Template
+}
+
+func (r *renderer) Render(invoice *domain.Invoice, format string) (*domain.InvoiceDocument, error) {
+	var (
+		buf         bytes.Buffer
+		contentType string
+		err         error
+	)
+	switch format {
+	case "", FormatPDF:
+		contentType = "application/pdf"
+		err = renderPDF(&buf, invoice)
+	case FormatHTML:
+		contentType = "text/html; charset=utf-8"
+		err = r.html.Execute(&buf, invoice)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.InvoiceDocument{
+		Invoice:     invoice,
+		Content:     buf.Bytes(),
+		ContentType: contentType,
+	}, nil
+}
+
+func renderPDF(buf *bytes.Buffer, invoice *domain.Invoice) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle("Invoice "+invoice.Number, true)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, tr("Invoice "+invoice.Number), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, tr(fmt.Sprintf("Issued %s - Order %s", invoice.IssuedAt.Format("2006-01-02"), invoice.OrderId)), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(0, 6, tr(invoice.SellerName), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	if a := invoice.BillingAddress; a != nil {
+		pdf.Ln(2)
+		lines := []string{"Bill to:", a.Recipient, a.Line1, a.Line2, strings.TrimSpace(a.PostalCode + " " + a.City), a.Region, a.Country}
+		for _, line := range lines {
+			if line != "" {
+				pdf.CellFormat(0, 5, tr(line), "", 1, "L", false, 0, "")
+			}
+		}
+	}
+	pdf.Ln(6)
+
+	widths := []float64{70, 15, 25, 25, 20, 25}
+	header := []string{"Item", "Qty", "Unit price", "Net", "Tax", "Total"}
+	pdf.SetFont("Helvetica", "B", 10)
+	for i, h := range header {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		pdf.CellFormat(widths[i], 7, h, "B", 0, align, false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, line := range invoice.Lines {
+		pdf.CellFormat(widths[0], 7, tr(line.Name), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 7, fmt.Sprint(line.Quantity), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[2], 7, money(line.UnitPrice), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 7, money(line.Net), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], 7, money(line.Tax), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[5], 7, money(line.Total), "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(2)
+
+	labelWidth := widths[0] + widths[1] + widths[2] + widths[3] + widths[4]
+	totals := [][2]string{
+		{"Subtotal", money(invoice.Subtotal)},
+		{"Tax (" + percent(invoice.TaxRate) + ")", money(invoice.Tax)},
+		{"Total", money(invoice.Total)},
+	}
+	for i, t := range totals {
+		if i == len(totals)-1 {
+			pdf.SetFont("Helvetica", "B", 10)
+		}
+		pdf.CellFormat(labelWidth, 7, t[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[5], 7, t[1], "", 1, "R", false, 0, "")
+	}
+
+	return pdf.Output(buf)
+}
+
+func money(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+func percent(rate float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", rate*100), "0"), ".") + "%"
+}
+
+func NewRenderer() Renderer {
+	return &renderer{
+		html: template.Must(template.New("invoice").Funcs(funcs).Parse(htmlTemplate)),
+	}
+}
@@ -2,22 +2,38 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/config"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/events"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/proto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/invoice"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/migrations"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/payment"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/service"
-	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/utils"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/app"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/health"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tracing"
 	"log/slog"
+	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrate(os.Args[2:]))
+	}
+
 	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 
 	cfg, err := config.NewConfig()
@@ -26,106 +42,174 @@ func main() {
 		os.Exit(1)
 	}
 
-	postgres := utils.NewPostgresql(
-		utils.WithHost(cfg.Postgresql.Host),
-		utils.WithPort(cfg.Postgresql.Port),
-		utils.WithUser(cfg.Postgresql.User),
-		utils.WithPassword(cfg.Postgresql.Password),
-		utils.WithName(cfg.Postgresql.Name),
-		utils.WithMaxOpenConns(cfg.Postgresql.MaxOpenConns),
-		utils.WithMaxIdleConns(cfg.Postgresql.MaxIdleConns),
-		utils.WithMaxIdleTime(cfg.Postgresql.MaxIdleTime),
-		utils.WithSSLMode(cfg.Postgresql.SSLMode),
-		utils.WithTimeout(cfg.Postgresql.Timeout),
-	)
+	a := app.New("order", cfg.Application.ShutdownTimeout)
 
-	db, err := postgres.Connect()
+	shutdownTracing, err := tracing.Setup(context.Background(), "order", cfg.Tracing.Exporter)
 	if err != nil {
-		slog.Error("postgres.connect.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("tracing.setup.failed", err)
+	}
+	a.Append(app.Hook{Name: "tracing", Stop: shutdownTracing})
+
+	creds, err := mtls.NewCredentials(mtls.Config{
+		CertFile:     cfg.TLS.CertFile,
+		KeyFile:      cfg.TLS.KeyFile,
+		CAFile:       cfg.TLS.CAFile,
+		AllowedPeers: cfg.TLS.AllowedPeers,
+	})
+	if err != nil {
+		a.Fatal("tls.load.failed", err)
 	}
 
-	defer func() {
-		if closeErr := db.Close(); closeErr != nil {
-			slog.Error("postgres.close.failed", slog.String("error", closeErr.Error()))
-		}
-	}()
+	db, err := postgres.Connect(context.Background(), cfg.Postgresql)
+	if err != nil {
+		a.Fatal("postgres.connect.failed", err)
+	}
+	a.Append(app.Closer("postgres", db.Close))
 
-	migrator, err := migrations.NewMigrator(db, postgres.Name)
+	router, err := postgres.NewRouter(db, cfg.Postgresql)
 	if err != nil {
-		slog.Error("migrations.init.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("postgres.replicas.failed", err)
 	}
+	a.Append(app.Closer("postgres.replicas", router.Close))
 
-	defer func() {
-		if closeErr := migrator.Close(); closeErr != nil {
-			slog.Error("migrations.close.failed", slog.String("error", closeErr.Error()))
+	metrics.RegisterDB(db, cfg.Postgresql.Name)
+
+	if cfg.Application.MigrateOnStart {
+		// The migrator closes the owner's pool when it's closed.
+		owner, err := postgres.ConnectOwner(context.Background(), cfg.Postgresql)
+		if err != nil {
+			a.Fatal("postgres.owner.connect.failed", err)
+		}
+		migrator, err := migrations.NewMigrator(owner, cfg.Postgresql.Name)
+		if err != nil {
+			a.Fatal("migrations.init.failed", err)
 		}
-	}()
+		a.Append(
+			app.Closer("migrations", migrator.Close),
+			app.Hook{Name: "migrations", Start: migrator.Up},
+		)
+	}
 
-	if err := migrator.Up(); err != nil {
-		slog.Error("migrations.up.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+	retry := grpcx.RetryPolicy{
+		MaxAttempts:    cfg.Resilience.RetryMaxAttempts,
+		InitialBackoff: cfg.Resilience.RetryInitialBackoff,
+		MaxBackoff:     cfg.Resilience.RetryMaxBackoff,
 	}
+	accountBreaker := grpcx.NewBreaker("account", cfg.Resilience.BreakerFailures, cfg.Resilience.BreakerCooldown)
+	catalogBreaker := grpcx.NewBreaker("catalog", cfg.Resilience.BreakerFailures, cfg.Resilience.BreakerCooldown)
 
-	accountClient, err := accountHandler.NewGRPCAccountClient(cfg.Application.AccountPort)
+	accountClient, err := accountHandler.NewGRPCAccountClient(cfg.Application.AccountPort, creds, retry, accountBreaker)
 	if err != nil {
-		slog.Error("accountClient.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("accountClient.failed", err)
 	}
+	a.Append(app.Closer("accountClient", accountClient.Close))
 
-	defer func() {
-		if closeErr := accountClient.Close(); closeErr != nil {
-			slog.Error("accountClient.close.failed", slog.String("error", closeErr.Error()))
-		}
-	}()
-
-	catalogClient, err := catalogHandler.NewGRPCCatalogClient(cfg.Application.CatalogPort)
+	catalogClient, err := catalogHandler.NewGRPCCatalogClient(cfg.Application.CatalogPort, creds, retry, catalogBreaker)
 	if err != nil {
-		slog.Error("catalogClient.failed", slog.String("error", err.Error()))
-		os.Exit(1)
+		a.Fatal("catalogClient.failed", err)
 	}
-
-	defer func() {
-		if closeErr := catalogClient.Close(); closeErr != nil {
-			slog.Error("catalogClient.close.failed", slog.String("error", closeErr.Error()))
-		}
-	}()
-
-	orderRepository := repository.NewOrderRepository(db, db)
-	orderService := service.NewOrderService(orderRepository)
-	orderGRPCServer := orderHandler.NewGRPCOrderServer(orderService, accountClient, catalogClient)
-
-	serverErrCh := make(chan error, 1)
-	go func() {
-		slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.OrderPort))
-		serverErrCh <- orderGRPCServer.Serve(cfg.Application.OrderPort)
-	}()
-
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-	select {
-	case sig := <-sigCh:
-		slog.Info("shutdown.signal.received", slog.String("signal", sig.String()))
-	case err := <-serverErrCh:
-		slog.Error("grpc.server.failed", slog.String("error", err.Error()))
+	a.Append(app.Closer("catalogClient", catalogClient.Close))
+
+	var paymentProvider payment.PaymentProvider
+	switch cfg.Payment.Provider {
+	case "", "fake":
+		paymentProvider = payment.NewFakeProvider(cfg.Payment.WebhookSecret)
+	default:
+		a.Fatal("payment.provider.unknown", fmt.Errorf("unknown payment provider %q", cfg.Payment.Provider))
 	}
 
-	slog.Info("shutdown.initiating", slog.String("timeout", "30s"))
-
-	if stopErr := orderGRPCServer.Stop(); stopErr != nil {
-		slog.Error("grpc.stop.failed", slog.String("error", stopErr.Error()))
+	broadcaster := events.NewBroadcaster()
+	orderRepository := events.NewOrderRepository(repository.NewOrderRepository(router, router), broadcaster)
+	paymentRepository := repository.NewPaymentRepository(router, router)
+	returnRepository := repository.NewReturnRepository(router, router)
+	shipmentRepository := repository.NewShipmentRepository(router, router)
+	reportRepository := repository.NewReportRepository(router, router)
+	invoiceRepository := repository.NewInvoiceRepository(router, router)
+	invoiceService := service.NewInvoiceService(cfg.Invoice.SellerName, cfg.Invoice.TaxRate, invoice.NewRenderer(), orderRepository, invoiceRepository)
+	paymentService := service.NewPaymentService(paymentProvider, orderRepository, paymentRepository, invoiceService)
+	orderService := service.NewOrderService(orderRepository, shipmentRepository, paymentService)
+	returnService := service.NewReturnService(orderRepository, returnRepository, paymentService)
+	shipmentService := service.NewShipmentService(orderRepository, shipmentRepository)
+	reportService := service.NewReportService(reportRepository)
+
+	accountHealth, err := health.NewRemote(cfg.Application.AccountPort, creds.DialOption())
+	if err != nil {
+		a.Fatal("accountHealth.failed", err)
 	}
+	a.Append(app.Closer("accountHealth", accountHealth.Close))
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer shutdownCancel()
-	select {
-	case <-shutdownCtx.Done():
-		slog.Warn("shutdown.timeout.reached")
-	case <-time.After(5 * time.Second):
-		slog.Info("shutdown.drain.complete")
+	catalogHealth, err := health.NewRemote(cfg.Application.CatalogPort, creds.DialOption())
+	if err != nil {
+		a.Fatal("catalogHealth.failed", err)
 	}
+	a.Append(app.Closer("catalogHealth", catalogHealth.Close))
+
+	checker := health.NewChecker(proto.OrderService_ServiceDesc.ServiceName, map[string]health.Check{
+		"postgres": db.PingContext,
+	}, map[string]health.Check{
+		"account":         accountHealth.Check,
+		"account_breaker": accountBreaker.Check,
+		"catalog":         catalogHealth.Check,
+		"catalog_breaker": catalogBreaker.Check,
+	})
+	orderGRPCServer := orderHandler.NewGRPCOrderServer(orderService, paymentService, returnService, shipmentService, reportService, invoiceService, broadcaster, accountClient, catalogClient, checker, cfg.Application.GRPCReflection, creds)
+	metricsServer := metrics.NewServer(cfg.Application.MetricsPort)
+
+	a.Append(
+		app.Hook{
+			Name: "report.refresh",
+			Run: func(ctx context.Context) error {
+				ticker := time.NewTicker(cfg.Report.RefreshInterval)
+				defer ticker.Stop()
+				for {
+					for _, id := range cfg.Tenant.Tenants() {
+						if err := reportService.RefreshAggregates(tenant.NewContext(ctx, id)); err != nil && ctx.Err() == nil {
+							slog.Error("report.refresh.failed", slog.String("tenant", id), slog.String("error", err.Error()))
+						}
+					}
+					select {
+					case <-ctx.Done():
+						return nil
+					case <-ticker.C:
+					}
+				}
+			},
+		},
+		app.Hook{
+			Name: "postgres.replicas",
+			Run:  router.Run,
+		},
+		app.Hook{
+			Name: "health",
+			Run: func(ctx context.Context) error {
+				checker.Run(ctx)
+				return nil
+			},
+		},
+		app.Hook{
+			Name: "metrics.server",
+			Run: func(context.Context) error {
+				slog.Info("metrics.server.starting", slog.String("addr", cfg.Application.MetricsPort))
+				if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			},
+			Stop: metricsServer.Shutdown,
+		},
+		app.Hook{
+			Name: "grpc.server",
+			Run: func(context.Context) error {
+				slog.Info("grpc.server.starting", slog.String("addr", cfg.Application.OrderPort))
+				return orderGRPCServer.Serve(cfg.Application.OrderPort)
+			},
+			Stop: func(context.Context) error {
+				return orderGRPCServer.Stop()
+			},
+		},
+	)
 
-	close(sigCh)
-	slog.Info("shutdown.complete")
+	if err := a.Run(context.Background()); err != nil {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/config"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/migrations"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/migration"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// migrate runs "order migrate ..." against the database in the service's
+// configuration, as its migration user, and returns the exit code.
+func migrate(args []string) int {
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	open := func(ctx context.Context) (*migration.Migrator, func() error, error) {
+		cfg, err := config.NewConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		db, err := postgres.ConnectOwner(ctx, cfg.Postgresql)
+		if err != nil {
+			return nil, nil, err
+		}
+		migrator, err := migrations.NewMigrator(db, cfg.Postgresql.Name)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return migrator, db.Close, nil
+	}
+
+	err := migration.Command(ctx, "order", args, migrations.Dir, open, os.Stdout)
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, migration.ErrUsage):
+		slog.Error("migrate.usage", slog.String("error", err.Error()))
+		return 2
+	default:
+		slog.Error("migrate.failed", slog.String("error", err.Error()))
+		return 1
+	}
+}
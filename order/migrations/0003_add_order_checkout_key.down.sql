@@ -0,0 +1 @@
+ALTER TABLE "order" DROP COLUMN IF EXISTS checkout_key
\ No newline at end of file
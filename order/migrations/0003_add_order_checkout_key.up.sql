@@ -0,0 +1,3 @@
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS checkout_key CHAR(27);
+
+ALTER TABLE "order" ADD CONSTRAINT order_checkout_key_key UNIQUE (checkout_key)
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS status VARCHAR(32) NOT NULL DEFAULT 'pending'
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS payment;
\ No newline at end of file
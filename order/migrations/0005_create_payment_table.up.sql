@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS payment (
+    id CHAR(27) PRIMARY KEY,
+    order_id CHAR(27) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    provider VARCHAR(32) NOT NULL,
+    provider_reference VARCHAR(255) NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    refunded_amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    status VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (provider, provider_reference)
+);
+
+CREATE INDEX IF NOT EXISTS payment_order_id_idx ON payment (order_id);
+
+-- An order has at most one payment holding or having taken its money, so
+-- concurrent or retried payOrder calls can't both capture.
+CREATE UNIQUE INDEX IF NOT EXISTS payment_order_id_live_key ON payment (order_id)
+    WHERE status IN ('authorized', 'captured', 'partially_refunded')
\ No newline at end of file
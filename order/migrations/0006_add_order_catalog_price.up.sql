@@ -0,0 +1 @@
+ALTER TABLE order_catalog ADD COLUMN IF NOT EXISTS price NUMERIC(12, 2) NOT NULL DEFAULT 0
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS order_return;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS order_return (
+    id CHAR(27) PRIMARY KEY,
+    order_id CHAR(27) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    catalog_id CHAR(27) NOT NULL,
+    quantity INT NOT NULL,
+    restocked_quantity INT NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    refund_amount NUMERIC(12, 2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_return_order_id_idx ON order_return (order_id)
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "order" DROP COLUMN IF EXISTS shipping_address;
\ No newline at end of file
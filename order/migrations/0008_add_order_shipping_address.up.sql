@@ -0,0 +1 @@
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_address JSONB;
\ No newline at end of file
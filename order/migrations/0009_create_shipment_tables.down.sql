@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS shipment_event;
+DROP TABLE IF EXISTS shipment_line;
+DROP TABLE IF EXISTS shipment;
\ No newline at end of file
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS shipment (
+    id CHAR(27) PRIMARY KEY,
+    order_id CHAR(27) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    carrier VARCHAR(64) NOT NULL,
+    tracking_number VARCHAR(128) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS shipment_order_id_idx ON shipment (order_id);
+
+CREATE TABLE IF NOT EXISTS shipment_line (
+    shipment_id CHAR(27) NOT NULL REFERENCES shipment (id) ON DELETE CASCADE,
+    catalog_id CHAR(27) NOT NULL,
+    quantity INT NOT NULL,
+    PRIMARY KEY (shipment_id, catalog_id)
+);
+
+CREATE TABLE IF NOT EXISTS shipment_event (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id CHAR(27) NOT NULL REFERENCES shipment (id) ON DELETE CASCADE,
+    status VARCHAR(32) NOT NULL,
+    description TEXT NOT NULL,
+    location VARCHAR(128) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS shipment_event_shipment_id_idx ON shipment_event (shipment_id, occurred_at)
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS order_account_id_created_at_idx;
\ No newline at end of file
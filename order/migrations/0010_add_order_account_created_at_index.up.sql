@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS order_account_id_created_at_idx ON "order" (account_id, created_at, id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS order_updated_at_idx;
+ALTER TABLE "order" DROP COLUMN IF EXISTS updated_at;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE;
+UPDATE "order" SET updated_at = created_at WHERE updated_at IS NULL;
+ALTER TABLE "order" ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS order_updated_at_idx ON "order" (updated_at);
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS report_watermark;
+DROP TABLE IF EXISTS sales_daily_customer;
+DROP TABLE IF EXISTS sales_daily_product;
+DROP TABLE IF EXISTS sales_daily;
\ No newline at end of file
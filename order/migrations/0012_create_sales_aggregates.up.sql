@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS sales_daily (
+    day DATE PRIMARY KEY,
+    revenue NUMERIC(14, 2) NOT NULL,
+    order_count INT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sales_daily_product (
+    day DATE NOT NULL,
+    catalog_id CHAR(27) NOT NULL,
+    quantity INT NOT NULL,
+    revenue NUMERIC(14, 2) NOT NULL,
+    PRIMARY KEY (day, catalog_id)
+);
+
+CREATE TABLE IF NOT EXISTS sales_daily_customer (
+    day DATE NOT NULL,
+    account_id CHAR(27) NOT NULL,
+    order_count INT NOT NULL,
+    PRIMARY KEY (day, account_id)
+);
+
+CREATE INDEX IF NOT EXISTS sales_daily_customer_account_id_idx ON sales_daily_customer (account_id, day);
+
+CREATE TABLE IF NOT EXISTS report_watermark (
+    name VARCHAR(32) PRIMARY KEY,
+    refreshed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+INSERT INTO report_watermark (name, refreshed_at) VALUES ('sales', 'epoch') ON CONFLICT (name) DO NOTHING
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE order_catalog DROP COLUMN IF EXISTS name;
\ No newline at end of file
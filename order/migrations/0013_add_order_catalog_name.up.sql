@@ -0,0 +1 @@
+ALTER TABLE order_catalog ADD COLUMN IF NOT EXISTS name VARCHAR(255) NOT NULL DEFAULT ''
\ No newline at end of file
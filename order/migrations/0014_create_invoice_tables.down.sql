@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS invoice;
+DROP TABLE IF EXISTS invoice_sequence;
\ No newline at end of file
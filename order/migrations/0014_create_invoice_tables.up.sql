@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS invoice_sequence (
+    year INT PRIMARY KEY,
+    last_number BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS invoice (
+    id CHAR(27) PRIMARY KEY,
+    order_id CHAR(27) NOT NULL UNIQUE REFERENCES "order" (id),
+    account_id CHAR(27) NOT NULL,
+    number VARCHAR(32) NOT NULL UNIQUE,
+    year INT NOT NULL,
+    sequence BIGINT NOT NULL,
+    seller_name VARCHAR(255) NOT NULL,
+    billing_address JSONB,
+    lines JSONB NOT NULL,
+    subtotal NUMERIC(12, 2) NOT NULL,
+    tax_rate NUMERIC(6, 4) NOT NULL,
+    tax NUMERIC(12, 2) NOT NULL,
+    total NUMERIC(12, 2) NOT NULL,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (year, sequence)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_account_id_idx ON invoice (account_id)
\ No newline at end of file
@@ -0,0 +1,27 @@
+ALTER TABLE "order" DROP CONSTRAINT order_checkout_key_key, ADD CONSTRAINT order_checkout_key_key UNIQUE (checkout_key);
+ALTER TABLE invoice
+    DROP CONSTRAINT invoice_tenant_id_number_key,
+    DROP CONSTRAINT invoice_tenant_id_year_sequence_key,
+    ADD UNIQUE (number),
+    ADD UNIQUE (year, sequence);
+ALTER TABLE invoice_sequence DROP CONSTRAINT invoice_sequence_pkey, ADD PRIMARY KEY (year);
+ALTER TABLE report_watermark DROP CONSTRAINT report_watermark_pkey, ADD PRIMARY KEY (name);
+ALTER TABLE sales_daily_customer DROP CONSTRAINT sales_daily_customer_pkey, ADD PRIMARY KEY (day, account_id);
+ALTER TABLE sales_daily_product DROP CONSTRAINT sales_daily_product_pkey, ADD PRIMARY KEY (day, catalog_id);
+ALTER TABLE sales_daily DROP CONSTRAINT sales_daily_pkey, ADD PRIMARY KEY (day);
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'order', 'order_catalog', 'payment', 'order_return', 'shipment', 'shipment_line', 'shipment_event',
+        'sales_daily', 'sales_daily_product', 'sales_daily_customer', 'report_watermark',
+        'invoice_sequence', 'invoice'
+    ] LOOP
+        EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', t);
+        EXECUTE format('ALTER TABLE %I NO FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I DISABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I DROP COLUMN IF EXISTS tenant_id', t);
+    END LOOP;
+END $$
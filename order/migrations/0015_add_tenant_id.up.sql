@@ -0,0 +1,31 @@
+-- Rows from before tenants belong to the default tenant; see the account
+-- migration of the same name.
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'order', 'order_catalog', 'payment', 'order_return', 'shipment', 'shipment_line', 'shipment_event',
+        'sales_daily', 'sales_daily_product', 'sales_daily_customer', 'report_watermark',
+        'invoice_sequence', 'invoice'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT %L', t, 'default');
+        EXECUTE format('ALTER TABLE %I ALTER COLUMN tenant_id SET DEFAULT NULLIF(current_setting(%L, true), %L)', t, 'app.tenant_id', '');
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = NULLIF(current_setting(%L, true), %L))', t, 'app.tenant_id', '');
+    END LOOP;
+END $$;
+
+-- Reports and invoice numbers are kept per tenant.
+ALTER TABLE sales_daily DROP CONSTRAINT sales_daily_pkey, ADD PRIMARY KEY (tenant_id, day);
+ALTER TABLE sales_daily_product DROP CONSTRAINT sales_daily_product_pkey, ADD PRIMARY KEY (tenant_id, day, catalog_id);
+ALTER TABLE sales_daily_customer DROP CONSTRAINT sales_daily_customer_pkey, ADD PRIMARY KEY (tenant_id, day, account_id);
+ALTER TABLE report_watermark DROP CONSTRAINT report_watermark_pkey, ADD PRIMARY KEY (tenant_id, name);
+ALTER TABLE invoice_sequence DROP CONSTRAINT invoice_sequence_pkey, ADD PRIMARY KEY (tenant_id, year);
+ALTER TABLE invoice
+    DROP CONSTRAINT invoice_number_key,
+    DROP CONSTRAINT invoice_year_sequence_key,
+    ADD UNIQUE (tenant_id, number),
+    ADD UNIQUE (tenant_id, year, sequence);
+ALTER TABLE "order" DROP CONSTRAINT order_checkout_key_key, ADD CONSTRAINT order_checkout_key_key UNIQUE (tenant_id, checkout_key)
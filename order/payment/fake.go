@@ -0,0 +1,110 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+)
+
+// DeclinedPaymentMethod makes the fake provider decline the authorization.
+const DeclinedPaymentMethod = "fake_declined"
+
+type fakeAuthorization struct {
+	amount   float64
+	captured float64
+	refunded float64
+	voided   bool
+}
+
+type fakeProvider struct {
+	secret         string
+	mu             sync.Mutex
+	authorizations map[string]*fakeAuthorization
+}
+
+func (f *fakeProvider) Name() string {
+	return "fake"
+}
+
+// Authorize derives the reference from the payment id, so that every attempt
+// to pay an order, including retries after a failed capture, gets its own.
+func (f *fakeProvider) Authorize(ctx context.Context, input *AuthorizeInput) (*Result, error) {
+	if input.PaymentMethod == DeclinedPaymentMethod {
+		return &Result{Status: StatusDeclined}, ErrDeclined
+	}
+
+	reference := f.reference(input.PaymentId)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.authorizations[reference] = &fakeAuthorization{amount: input.Amount}
+	return &Result{Reference: reference, Status: StatusSucceeded}, nil
+}
+
+func (f *fakeProvider) Capture(ctx context.Context, reference string, amount float64) (*Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth, ok := f.authorizations[reference]
+	if !ok || auth.voided {
+		return nil, ErrUnknownReference
+	}
+	auth.captured = amount
+	return &Result{Reference: reference, Status: StatusSucceeded}, nil
+}
+
+func (f *fakeProvider) Void(ctx context.Context, reference string) (*Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth, ok := f.authorizations[reference]
+	if !ok {
+		return nil, ErrUnknownReference
+	}
+	auth.voided = true
+	return &Result{Reference: reference, Status: StatusSucceeded}, nil
+}
+
+func (f *fakeProvider) Refund(ctx context.Context, reference string, amount float64) (*Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth, ok := f.authorizations[reference]
+	if !ok {
+		// The fake keeps no state across restarts; treat unknown references as
+		// previously captured so refunds keep working in local environments.
+		auth = &fakeAuthorization{amount: amount, captured: amount}
+		f.authorizations[reference] = auth
+	}
+	auth.refunded += amount
+	return &Result{Reference: reference, Status: StatusSucceeded}, nil
+}
+
+func (f *fakeProvider) ParseWebhook(payload []byte, signature string) (*Event, error) {
+	if !hmac.Equal([]byte(f.Sign(payload)), []byte(signature)) {
+		return nil, ErrInvalidSignature
+	}
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// Sign returns the signature the fake provider expects for a webhook payload.
+func (f *fakeProvider) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(f.secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (f *fakeProvider) reference(paymentId string) string {
+	sum := sha256.Sum256([]byte(paymentId))
+	return "fake_" + hex.EncodeToString(sum[:12])
+}
+
+func NewFakeProvider(secret string) PaymentProvider {
+	return &fakeProvider{
+		secret:         secret,
+		authorizations: make(map[string]*fakeAuthorization),
+	}
+}
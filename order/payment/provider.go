@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	StatusSucceeded = "succeeded"
+	StatusPending   = "pending"
+	StatusDeclined  = "declined"
+)
+
+const (
+	EventPaymentCaptured = "payment.captured"
+	EventPaymentFailed   = "payment.failed"
+	EventPaymentVoided   = "payment.voided"
+	EventRefundSucceeded = "refund.succeeded"
+)
+
+var (
+	ErrDeclined         = errors.New("payment declined")
+	ErrUnknownReference = errors.New("unknown payment reference")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// PaymentProvider is implemented by every payment backend the order service
+// can charge through. Amounts are in the order currency.
+type PaymentProvider interface {
+	Name() string
+	Authorize(ctx context.Context, input *AuthorizeInput) (*Result, error)
+	Capture(ctx context.Context, reference string, amount float64) (*Result, error)
+	Void(ctx context.Context, reference string) (*Result, error)
+	Refund(ctx context.Context, reference string, amount float64) (*Result, error)
+	ParseWebhook(payload []byte, signature string) (*Event, error)
+}
+
+type AuthorizeInput struct {
+	// PaymentId is new for every attempt to pay an order.
+	PaymentId     string
+	OrderId       string
+	Amount        float64
+	PaymentMethod string
+}
+
+type Result struct {
+	Reference string
+	Status    string
+}
+
+type Event struct {
+	Type      string  `json:"type"`
+	Reference string  `json:"reference"`
+	Amount    float64 `json:"amount"`
+}
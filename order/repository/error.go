@@ -0,0 +1,14 @@
+package repository
+
+import "errors"
+
+var (
+	ErrNoRows        = errors.New("record not found")
+	ErrInvoiceExists = errors.New("order is already invoiced")
+	// ErrCheckedOut is returned for an order whose checkout key an earlier
+	// order was placed with.
+	ErrCheckedOut = errors.New("checkout already placed an order")
+	// ErrPaymentExists is returned for a payment of an order that another
+	// payment holds or took the money of.
+	ErrPaymentExists = errors.New("order already has a live payment")
+)
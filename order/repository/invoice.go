@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+const invoiceNumberFormat = "INV-%d-%06d"
+
+type InvoiceRepository interface {
+	CreateInvoice(ctx context.Context, invoice *domain.Invoice) error
+	GetInvoiceById(ctx context.Context, id string) (*domain.Invoice, error)
+	GetInvoiceForOrder(ctx context.Context, orderId string) (*domain.Invoice, error)
+}
+
+type invoiceRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+// CreateInvoice takes the next number of the invoice's issue year and stores
+// the invoice in the same transaction. The sequence row stays locked until
+// commit and a failed insert rolls the increment back, so numbers are issued
+// without gaps. It fails with ErrInvoiceExists if the order already has one.
+func (i *invoiceRepository) CreateInvoice(ctx context.Context, invoice *domain.Invoice) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	billingAddress, err := json.Marshal(invoice.BillingAddress)
+	if err != nil {
+		return err
+	}
+	lines, err := json.Marshal(invoice.Lines)
+	if err != nil {
+		return err
+	}
+
+	tx, err := i.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	year := invoice.IssuedAt.UTC().Year()
+	var sequence uint64
+	err = tx.QueryRowContext(ctx, `
+INSERT INTO invoice_sequence (year, last_number) VALUES ($1, 1)
+ON CONFLICT (tenant_id, year) DO UPDATE SET last_number = invoice_sequence.last_number + 1
+RETURNING last_number`, year).Scan(&sequence)
+	if err != nil {
+		return err
+	}
+
+	number := fmt.Sprintf(invoiceNumberFormat, year, sequence)
+	res, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO invoice(id, order_id, account_id, number, year, sequence, seller_name, billing_address, lines, subtotal, tax_rate, tax, total, issued_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+ON CONFLICT (order_id) DO NOTHING`,
+		invoice.Id,
+		invoice.OrderId,
+		invoice.AccountId,
+		number,
+		year,
+		sequence,
+		invoice.SellerName,
+		billingAddress,
+		lines,
+		invoice.Subtotal,
+		invoice.TaxRate,
+		invoice.Tax,
+		invoice.Total,
+		invoice.IssuedAt,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		err = ErrInvoiceExists
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	invoice.Number = number
+	invoice.Year = year
+	invoice.Sequence = sequence
+	return nil
+}
+
+func (i *invoiceRepository) GetInvoiceById(ctx context.Context, id string) (*domain.Invoice, error) {
+	return i.getInvoice(ctx, `id = $1`, id)
+}
+
+func (i *invoiceRepository) GetInvoiceForOrder(ctx context.Context, orderId string) (*domain.Invoice, error) {
+	return i.getInvoice(ctx, `order_id = $1`, orderId)
+}
+
+func (i *invoiceRepository) getInvoice(ctx context.Context, where string, arg any) (*domain.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var (
+		invoice        domain.Invoice
+		billingAddress []byte
+		lines          []byte
+	)
+	err := i.dbRead.QueryRowContext(ctx, `
+SELECT id, order_id, account_id, number, year, sequence, seller_name, billing_address, lines, subtotal::float8, tax_rate::float8, tax::float8, total::float8, issued_at
+FROM invoice
+WHERE `+where, arg).Scan(
+		&invoice.Id,
+		&invoice.OrderId,
+		&invoice.AccountId,
+		&invoice.Number,
+		&invoice.Year,
+		&invoice.Sequence,
+		&invoice.SellerName,
+		&billingAddress,
+		&lines,
+		&invoice.Subtotal,
+		&invoice.TaxRate,
+		&invoice.Tax,
+		&invoice.Total,
+		&invoice.IssuedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNoRows
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(billingAddress, &invoice.BillingAddress); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(lines, &invoice.Lines); err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+func NewInvoiceRepository(dbWrite postgres.Writer, dbRead postgres.Reader) InvoiceRepository {
+	return &invoiceRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
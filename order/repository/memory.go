@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryOrderRepository keeps orders in a map, for tests and local runs
+// without Postgres. Orders are copied in and out, lines included, so callers
+// can't change what it holds. Like row-level security, it hides the orders
+// of other tenants, and of every tenant from a context without one, which
+// can't create any either.
+type memoryOrderRepository struct {
+	mu     sync.RWMutex
+	orders map[string]*domain.Order
+	// tenants holds the tenant of each order.
+	tenants map[string]string
+}
+
+func (m *memoryOrderRepository) CreateOrder(ctx context.Context, order *domain.Order) error {
+	tenantId := tenant.FromContext(ctx)
+	if tenantId == "" {
+		return tenant.ErrMissing
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.orders[order.Id]; ok {
+		return fmt.Errorf("order %s already exists", order.Id)
+	}
+	if order.CheckoutKey != "" {
+		for id, o := range m.orders {
+			if o.CheckoutKey == order.CheckoutKey && m.tenants[id] == tenantId {
+				order.Id = id
+				return ErrCheckedOut
+			}
+		}
+	}
+	m.orders[order.Id] = copyOrder(order)
+	m.tenants[order.Id] = tenantId
+	return nil
+}
+
+// get returns order id if it belongs to the tenant of ctx.
+func (m *memoryOrderRepository) get(ctx context.Context, id string) (*domain.Order, bool) {
+	order, ok := m.orders[id]
+	if !ok || m.tenants[id] != tenant.FromContext(ctx) {
+		return nil, false
+	}
+	return order, true
+}
+
+func (m *memoryOrderRepository) GetOrderById(ctx context.Context, id string) (*domain.Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	order, ok := m.get(ctx, id)
+	if !ok {
+		return nil, ErrNoRows
+	}
+	return copyOrder(order), nil
+}
+
+func (m *memoryOrderRepository) GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tenantId := tenant.FromContext(ctx)
+	var orders []*domain.Order
+	for id, order := range m.orders {
+		if m.tenants[id] == tenantId && slices.Contains(ids, id) {
+			orders = append(orders, copyOrder(order))
+		}
+	}
+	slices.SortFunc(orders, func(a, b *domain.Order) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return orders, nil
+}
+
+// GetOrdersForAccount pages through an account's orders like the Postgres
+// repository: filtered, keyset-paged on (created_at, id), sorted both ways.
+func (m *memoryOrderRepository) GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, error) {
+	var cursor *dto.OrderCursor
+	if query.Cursor != "" {
+		var err error
+		if cursor, err = dto.DecodeOrderCursor(query.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	desc := query.Sort != dto.SortAsc
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tenantId := tenant.FromContext(ctx)
+	var orders []*domain.Order
+	for id, order := range m.orders {
+		switch {
+		case m.tenants[id] != tenantId,
+			order.AccountId != query.AccountId,
+			!query.CreatedFrom.IsZero() && order.CreatedAt.Before(query.CreatedFrom),
+			!query.CreatedTo.IsZero() && !order.CreatedAt.Before(query.CreatedTo),
+			len(query.Statuses) > 0 && !slices.Contains(query.Statuses, order.Status),
+			query.MinTotal != nil && order.TotalPrice < *query.MinTotal,
+			query.MaxTotal != nil && order.TotalPrice > *query.MaxTotal:
+			continue
+		}
+		if cursor != nil {
+			c := compareOrder(order, cursor.CreatedAt, cursor.Id)
+			if desc && c >= 0 || !desc && c <= 0 {
+				continue
+			}
+		}
+		orders = append(orders, copyOrder(order))
+	}
+
+	slices.SortFunc(orders, func(a, b *domain.Order) int {
+		c := compareOrder(a, b.CreatedAt, b.Id)
+		if desc {
+			return -c
+		}
+		return c
+	})
+	if uint64(len(orders)) > query.Limit {
+		orders = orders[:query.Limit]
+	}
+	return orders, nil
+}
+
+func (m *memoryOrderRepository) UpdateOrderStatus(ctx context.Context, id, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	order, ok := m.get(ctx, id)
+	if !ok {
+		return ErrNoRows
+	}
+	order.Status = status
+	return nil
+}
+
+func compareOrder(order *domain.Order, createdAt time.Time, id string) int {
+	if c := order.CreatedAt.Compare(createdAt); c != 0 {
+		return c
+	}
+	return strings.Compare(order.Id, id)
+}
+
+func copyOrder(order *domain.Order) *domain.Order {
+	o := *order
+	if order.ShippingAddress != nil {
+		address := *order.ShippingAddress
+		o.ShippingAddress = &address
+	}
+	o.Catalogs = make([]*domain.OrderedCatalog, len(order.Catalogs))
+	for i, c := range order.Catalogs {
+		catalog := *c
+		o.Catalogs[i] = &catalog
+	}
+	return &o
+}
+
+func NewMemoryOrderRepository() OrderRepository {
+	return &memoryOrderRepository{
+		orders:  make(map[string]*domain.Order),
+		tenants: make(map[string]string),
+	}
+}
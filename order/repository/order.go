@@ -3,19 +3,30 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"github.com/lib/pq"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"strings"
 	"time"
 )
 
 type OrderRepository interface {
+	// CreateOrder stores order. If an order was placed with its checkout key
+	// before, it stores nothing, sets order.Id to the earlier order's and
+	// returns ErrCheckedOut.
 	CreateOrder(ctx context.Context, order *domain.Order) error
-	GetOrdersForAccount(ctx context.Context, accountId string) ([]*domain.Order, error)
+	GetOrderById(ctx context.Context, id string) (*domain.Order, error)
+	GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error)
+	GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, error)
+	UpdateOrderStatus(ctx context.Context, id, status string) error
 }
 
 type orderRepository struct {
-	dbWrite *sql.DB
-	dbRead  *sql.DB
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
 }
 
 func (o *orderRepository) CreateOrder(ctx context.Context, order *domain.Order) error {
@@ -32,26 +43,49 @@ func (o *orderRepository) CreateOrder(ctx context.Context, order *domain.Order)
 		}
 	}()
 
-	_, err = tx.ExecContext(
+	var shippingAddress []byte
+	if order.ShippingAddress != nil {
+		shippingAddress, err = json.Marshal(order.ShippingAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := tx.ExecContext(
 		ctx,
-		`INSERT INTO "order"(id, created_at, account_id, total_price) VALUES($1, $2, $3, $4)`,
+		`INSERT INTO "order"(id, created_at, updated_at, account_id, total_price, status, shipping_address, checkout_key) VALUES($1, $2, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (tenant_id, checkout_key) DO NOTHING`,
 		order.Id,
 		order.CreatedAt,
 		order.AccountId,
 		order.TotalPrice,
+		order.Status,
+		shippingAddress,
+		nullString(order.CheckoutKey),
 	)
 	if err != nil {
 		return err
 	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		if err = tx.QueryRowContext(ctx, `SELECT id FROM "order" WHERE checkout_key=$1`, order.CheckoutKey).Scan(&order.Id); err != nil {
+			return err
+		}
+		err = ErrCheckedOut
+		return err
+	}
 
-	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("order_catalog", "order_id", "catalog_id", "quantity"))
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("order_catalog", "order_id", "catalog_id", "name", "quantity", "price"))
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
 	for _, c := range order.Catalogs {
-		_, err = stmt.ExecContext(ctx, order.Id, c.Id, c.Quantity)
+		_, err = stmt.ExecContext(ctx, order.Id, c.Id, c.Name, c.Quantity, c.Price)
 		if err != nil {
 			return err
 		}
@@ -70,25 +104,161 @@ func (o *orderRepository) CreateOrder(ctx context.Context, order *domain.Order)
 	return nil
 }
 
-func (o *orderRepository) GetOrdersForAccount(ctx context.Context, accountId string) ([]*domain.Order, error) {
+func (o *orderRepository) GetOrderById(ctx context.Context, id string) (*domain.Order, error) {
 	rows, err := o.dbRead.QueryContext(ctx, `
 SELECT
   o.id,
   o.created_at,
   o.account_id,
   o.total_price::money::numeric::float8,
+  o.status,
+  o.shipping_address,
   oc.catalog_id,
-  oc.quantity
+  oc.name,
+  oc.quantity,
+  oc.price::float8
 FROM "order" o
 LEFT JOIN order_catalog oc ON o.id = oc.order_id
-WHERE o.account_id = $1
+WHERE o.id = $1;
+`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders, err := scanOrders(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, ErrNoRows
+	}
+	return orders[0], nil
+}
+
+func (o *orderRepository) GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rows, err := o.dbRead.QueryContext(ctx, `
+SELECT
+  o.id,
+  o.created_at,
+  o.account_id,
+  o.total_price::money::numeric::float8,
+  o.status,
+  o.shipping_address,
+  oc.catalog_id,
+  oc.name,
+  oc.quantity,
+  oc.price::float8
+FROM "order" o
+LEFT JOIN order_catalog oc ON o.id = oc.order_id
+WHERE o.id = ANY($1)
 ORDER BY o.id;
-`, accountId)
+`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOrders(rows)
+}
+
+// GetOrdersForAccount returns one page of an account's orders matching the
+// query. The page is selected on "order" alone so that the limit counts
+// orders rather than order lines, and is then joined with its lines.
+func (o *orderRepository) GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	conditions := []string{"account_id = $1"}
+	args := []any{query.AccountId}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if !query.CreatedFrom.IsZero() {
+		conditions = append(conditions, "created_at >= "+arg(query.CreatedFrom))
+	}
+	if !query.CreatedTo.IsZero() {
+		conditions = append(conditions, "created_at < "+arg(query.CreatedTo))
+	}
+	if len(query.Statuses) > 0 {
+		conditions = append(conditions, "status = ANY("+arg(pq.Array(query.Statuses))+")")
+	}
+	if query.MinTotal != nil {
+		conditions = append(conditions, "total_price::numeric >= "+arg(*query.MinTotal))
+	}
+	if query.MaxTotal != nil {
+		conditions = append(conditions, "total_price::numeric <= "+arg(*query.MaxTotal))
+	}
+
+	direction, comparison := "DESC", "<"
+	if query.Sort == dto.SortAsc {
+		direction, comparison = "ASC", ">"
+	}
+	if query.Cursor != "" {
+		cursor, err := dto.DecodeOrderCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) %s (%s, %s)", comparison, arg(cursor.CreatedAt), arg(cursor.Id)))
+	}
+
+	rows, err := o.dbRead.QueryContext(ctx, fmt.Sprintf(`
+WITH page AS (
+  SELECT id, created_at
+  FROM "order"
+  WHERE %[1]s
+  ORDER BY created_at %[2]s, id %[2]s
+  LIMIT %[3]s
+)
+SELECT
+  o.id,
+  o.created_at,
+  o.account_id,
+  o.total_price::money::numeric::float8,
+  o.status,
+  o.shipping_address,
+  oc.catalog_id,
+  oc.name,
+  oc.quantity,
+  oc.price::float8
+FROM page p
+JOIN "order" o ON o.id = p.id
+LEFT JOIN order_catalog oc ON o.id = oc.order_id
+ORDER BY p.created_at %[2]s, p.id %[2]s;
+`, strings.Join(conditions, " AND "), direction, arg(query.Limit)), args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanOrders(rows)
+}
+
+func (o *orderRepository) UpdateOrderStatus(ctx context.Context, id, status string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := o.dbWrite.ExecContext(ctx, `UPDATE "order" SET status=$2, updated_at=$3 WHERE id=$1`, id, status, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoRows
+	}
+	return nil
+}
+
+// scanOrders folds the order/order_catalog join rows, grouped by order, into
+// one domain.Order per order.
+func scanOrders(rows *sql.Rows) ([]*domain.Order, error) {
 	var orders []*domain.Order
 	var currOrder *domain.Order
 	var currOrderID string
@@ -100,11 +270,15 @@ ORDER BY o.id;
 			createdAt  time.Time
 			accountID  string
 			totalPrice float64
+			status     string
+			address    []byte
 			catalogID  sql.NullString
+			name       sql.NullString
 			quantity   sql.NullInt64
+			price      sql.NullFloat64
 		)
 
-		if err := rows.Scan(&orderID, &createdAt, &accountID, &totalPrice, &catalogID, &quantity); err != nil {
+		if err := rows.Scan(&orderID, &createdAt, &accountID, &totalPrice, &status, &address, &catalogID, &name, &quantity, &price); err != nil {
 			return nil, err
 		}
 
@@ -123,8 +297,15 @@ ORDER BY o.id;
 				CreatedAt:  createdAt,
 				AccountId:  accountID,
 				TotalPrice: totalPrice,
+				Status:     status,
 				Catalogs:   nil,
 			}
+			if len(address) > 0 {
+				currOrder.ShippingAddress = &domain.ShippingAddress{}
+				if err := json.Unmarshal(address, currOrder.ShippingAddress); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		// append ordered product if exists
@@ -135,6 +316,8 @@ ORDER BY o.id;
 			}
 			catalogs = append(catalogs, &domain.OrderedCatalog{
 				Id:       catalogID.String,
+				Name:     name.String,
+				Price:    price.Float64,
 				Quantity: q,
 			})
 		}
@@ -153,7 +336,11 @@ ORDER BY o.id;
 	return orders, nil
 }
 
-func NewOrderRepository(dbWrite, dbRead *sql.DB) OrderRepository {
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func NewOrderRepository(dbWrite postgres.Writer, dbRead postgres.Reader) OrderRepository {
 	return &orderRepository{
 		dbWrite: dbWrite,
 		dbRead:  dbRead,
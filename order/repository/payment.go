@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+// livePaymentStatuses are those of payments that hold or took the order's
+// money; an order has at most one payment in them.
+const livePaymentStatuses = `('authorized', 'captured', 'partially_refunded')`
+
+const paymentColumns = `id, order_id, provider, provider_reference, amount::float8, refunded_amount::float8, status, created_at, updated_at`
+
+type PaymentRepository interface {
+	// CreatePayment stores payment, failing with ErrPaymentExists when the
+	// order already has a live one.
+	CreatePayment(ctx context.Context, payment *domain.Payment) error
+	GetPaymentForOrder(ctx context.Context, orderId string) (*domain.Payment, error)
+	GetPaymentByReference(ctx context.Context, provider, reference string) (*domain.Payment, error)
+	UpdatePayment(ctx context.Context, payment *domain.Payment) error
+	// AddRefund adds amount, which is negative to give back an earlier
+	// addition, to what the captured payment id has refunded and returns the
+	// payment. It fails with ErrNoRows when the payment isn't refundable or
+	// the total would drop below zero or exceed the payment's amount.
+	AddRefund(ctx context.Context, id string, amount float64) (*domain.Payment, error)
+	// RaiseRefund raises what payment id has refunded to total, unless it is
+	// at least that much already, and returns the payment. It fails with
+	// ErrNoRows when the payment isn't refundable or total exceeds its amount.
+	RaiseRefund(ctx context.Context, id string, total float64) (*domain.Payment, error)
+}
+
+type paymentRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+func (p *paymentRepository) CreatePayment(ctx context.Context, payment *domain.Payment) error {
+	query := `INSERT INTO payment(id, order_id, provider, provider_reference, amount, refunded_amount, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (order_id) WHERE status IN ` + livePaymentStatuses + ` DO NOTHING`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := p.dbWrite.ExecContext(ctx, query,
+		payment.Id,
+		payment.OrderId,
+		payment.Provider,
+		payment.ProviderReference,
+		payment.Amount,
+		payment.RefundedAmount,
+		payment.Status,
+		payment.CreatedAt,
+		payment.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrPaymentExists
+	}
+	return nil
+}
+
+// GetPaymentForOrder returns the most recent payment attempt of an order.
+func (p *paymentRepository) GetPaymentForOrder(ctx context.Context, orderId string) (*domain.Payment, error) {
+	query := `
+SELECT ` + paymentColumns + `
+FROM payment
+WHERE order_id = $1
+ORDER BY created_at DESC
+LIMIT 1`
+	return p.getPayment(ctx, query, orderId)
+}
+
+func (p *paymentRepository) GetPaymentByReference(ctx context.Context, provider, reference string) (*domain.Payment, error) {
+	query := `
+SELECT ` + paymentColumns + `
+FROM payment
+WHERE provider = $1 AND provider_reference = $2`
+	return p.getPayment(ctx, query, provider, reference)
+}
+
+func (p *paymentRepository) UpdatePayment(ctx context.Context, payment *domain.Payment) error {
+	query := `UPDATE payment SET refunded_amount=$2, status=$3, updated_at=$4 WHERE id=$1`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := p.dbWrite.ExecContext(ctx, query, payment.Id, payment.RefundedAmount, payment.Status, payment.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoRows
+	}
+	return nil
+}
+
+// AddRefund changes the total in a single statement, so concurrent refunds
+// can't both pass the check against the same total.
+func (p *paymentRepository) AddRefund(ctx context.Context, id string, amount float64) (*domain.Payment, error) {
+	total := `refunded_amount + $2`
+	query := `
+UPDATE payment SET refunded_amount = ` + total + `, status = ` + refundStatus(total) + `, updated_at = $3
+WHERE id = $1 AND status IN ('captured', 'partially_refunded') AND ` + total + ` BETWEEN 0 AND amount
+RETURNING ` + paymentColumns
+	return p.refund(ctx, query, id, amount)
+}
+
+func (p *paymentRepository) RaiseRefund(ctx context.Context, id string, total float64) (*domain.Payment, error) {
+	raised := `GREATEST(refunded_amount, $2)`
+	query := `
+UPDATE payment SET refunded_amount = ` + raised + `, status = ` + refundStatus(raised) + `, updated_at = $3
+WHERE id = $1 AND status IN ('captured', 'partially_refunded') AND $2 <= amount
+RETURNING ` + paymentColumns
+	return p.refund(ctx, query, id, total)
+}
+
+// refund runs query, an update of payment id that returns it, with amount
+// and the time of the update.
+func (p *paymentRepository) refund(ctx context.Context, query, id string, amount float64) (payment *domain.Payment, err error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := p.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if payment, err = scanPayment(tx.QueryRowContext(ctx, query, id, amount, time.Now().UTC())); err != nil {
+		return nil, err
+	}
+	return payment, tx.Commit()
+}
+
+// refundStatus is the status of a captured payment once it has refunded
+// total, an expression over its columns.
+func refundStatus(total string) string {
+	return `CASE WHEN ` + total + ` >= amount THEN 'refunded' WHEN ` + total + ` > 0 THEN 'partially_refunded' ELSE 'captured' END`
+}
+
+func (p *paymentRepository) getPayment(ctx context.Context, query string, args ...any) (*domain.Payment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	return scanPayment(p.dbRead.QueryRowContext(ctx, query, args...))
+}
+
+func scanPayment(row *sql.Row) (*domain.Payment, error) {
+	var payment domain.Payment
+	err := row.Scan(
+		&payment.Id,
+		&payment.OrderId,
+		&payment.Provider,
+		&payment.ProviderReference,
+		&payment.Amount,
+		&payment.RefundedAmount,
+		&payment.Status,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoRows
+		default:
+			return nil, err
+		}
+	}
+	return &payment, nil
+}
+
+func NewPaymentRepository(dbWrite postgres.Writer, dbRead postgres.Reader) PaymentRepository {
+	return &paymentRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+// refreshOverlap re-reads orders updated shortly before the last refresh, so
+// that updates committed after it started, but stamped before, are not missed.
+const refreshOverlap = time.Minute
+
+type ReportRepository interface {
+	RefreshSalesAggregates(ctx context.Context) error
+	GetRevenue(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.RevenueBucket, error)
+	GetTopProducts(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.ProductSales, error)
+	GetSalesSummary(ctx context.Context, query *dto.SalesReportQuery) (*domain.SalesSummary, error)
+	GetCustomerBreakdown(ctx context.Context, query *dto.SalesReportQuery) (*domain.CustomerBreakdown, error)
+}
+
+type reportRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+// RefreshSalesAggregates rebuilds the daily sales aggregates of the tenant of
+// ctx for every day that has an order updated since its previous refresh. Whole days are rebuilt, so
+// a status change that moves an order in or out of the sales is picked up.
+func (r *reportRepository) RefreshSalesAggregates(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	tx, err := r.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// The watermark of a tenant's first refresh is the epoch.
+	if _, err = tx.ExecContext(ctx, `INSERT INTO report_watermark (name, refreshed_at) VALUES ('sales', 'epoch') ON CONFLICT (tenant_id, name) DO NOTHING`); err != nil {
+		return err
+	}
+
+	var refreshedAt, startedAt time.Time
+	if err = tx.QueryRowContext(ctx, `SELECT refreshed_at, now() FROM report_watermark WHERE name = 'sales' FOR UPDATE`).Scan(&refreshedAt, &startedAt); err != nil {
+		return err
+	}
+
+	var days pq.StringArray
+	if err = tx.QueryRowContext(ctx, `
+SELECT COALESCE(ARRAY_AGG(DISTINCT to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')), '{}')
+FROM "order"
+WHERE updated_at > $1`, refreshedAt.Add(-refreshOverlap)).Scan(&days); err != nil {
+		return err
+	}
+
+	if len(days) > 0 {
+		statuses := pq.Array(domain.SalesStatuses)
+		for _, stmt := range []struct {
+			query string
+			args  []any
+		}{
+			{`DELETE FROM sales_daily WHERE day = ANY($1::date[])`, []any{days}},
+			{`DELETE FROM sales_daily_product WHERE day = ANY($1::date[])`, []any{days}},
+			{`DELETE FROM sales_daily_customer WHERE day = ANY($1::date[])`, []any{days}},
+			{`
+INSERT INTO sales_daily (day, revenue, order_count)
+SELECT (o.created_at AT TIME ZONE 'UTC')::date, SUM(o.total_price::numeric), COUNT(*)
+FROM "order" o
+WHERE (o.created_at AT TIME ZONE 'UTC')::date = ANY($1::date[]) AND o.status = ANY($2)
+GROUP BY 1`, []any{days, statuses}},
+			{`
+INSERT INTO sales_daily_product (day, catalog_id, quantity, revenue)
+SELECT (o.created_at AT TIME ZONE 'UTC')::date, oc.catalog_id, SUM(oc.quantity), SUM(oc.price * oc.quantity)
+FROM "order" o
+JOIN order_catalog oc ON oc.order_id = o.id
+WHERE (o.created_at AT TIME ZONE 'UTC')::date = ANY($1::date[]) AND o.status = ANY($2)
+GROUP BY 1, 2`, []any{days, statuses}},
+			{`
+INSERT INTO sales_daily_customer (day, account_id, order_count)
+SELECT (o.created_at AT TIME ZONE 'UTC')::date, o.account_id, COUNT(*)
+FROM "order" o
+WHERE (o.created_at AT TIME ZONE 'UTC')::date = ANY($1::date[]) AND o.status = ANY($2)
+GROUP BY 1, 2`, []any{days, statuses}},
+		} {
+			if _, err = tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE report_watermark SET refreshed_at = $1 WHERE name = 'sales'`, startedAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *reportRepository) GetRevenue(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.RevenueBucket, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rows, err := r.dbRead.QueryContext(ctx, `
+SELECT date_trunc($3, day)::date, SUM(revenue)::float8, SUM(order_count)
+FROM sales_daily
+WHERE day >= $1::date AND day < $2::date
+GROUP BY 1
+ORDER BY 1`, query.From, query.To, query.Granularity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]*domain.RevenueBucket, 0)
+	for rows.Next() {
+		var bucket domain.RevenueBucket
+		if err := rows.Scan(&bucket.PeriodStart, &bucket.Revenue, &bucket.OrderCount); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, &bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
+func (r *reportRepository) GetTopProducts(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.ProductSales, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rankBy := "quantity"
+	if query.RankBy == domain.ProductRankingRevenue {
+		rankBy = "revenue"
+	}
+
+	rows, err := r.dbRead.QueryContext(ctx, fmt.Sprintf(`
+SELECT catalog_id, SUM(quantity) AS quantity, SUM(revenue)::float8 AS revenue
+FROM sales_daily_product
+WHERE day >= $1::date AND day < $2::date
+GROUP BY catalog_id
+ORDER BY %s DESC, catalog_id
+LIMIT $3`, rankBy), query.From, query.To, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := make([]*domain.ProductSales, 0)
+	for rows.Next() {
+		var product domain.ProductSales
+		if err := rows.Scan(&product.CatalogId, &product.Quantity, &product.Revenue); err != nil {
+			return nil, err
+		}
+		products = append(products, &product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+func (r *reportRepository) GetSalesSummary(ctx context.Context, query *dto.SalesReportQuery) (*domain.SalesSummary, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var summary domain.SalesSummary
+	if err := r.dbRead.QueryRowContext(ctx, `
+SELECT COALESCE(SUM(revenue), 0)::float8, COALESCE(SUM(order_count), 0)
+FROM sales_daily
+WHERE day >= $1::date AND day < $2::date`, query.From, query.To).Scan(&summary.Revenue, &summary.OrderCount); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// GetCustomerBreakdown splits the customers who bought in the range into
+// those whose first purchase falls within it and those who bought before.
+func (r *reportRepository) GetCustomerBreakdown(ctx context.Context, query *dto.SalesReportQuery) (*domain.CustomerBreakdown, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var breakdown domain.CustomerBreakdown
+	if err := r.dbRead.QueryRowContext(ctx, `
+WITH active AS (
+  SELECT DISTINCT account_id
+  FROM sales_daily_customer
+  WHERE day >= $1::date AND day < $2::date
+), first_purchase AS (
+  SELECT c.account_id, MIN(c.day) AS day
+  FROM sales_daily_customer c
+  JOIN active a ON a.account_id = c.account_id
+  GROUP BY c.account_id
+)
+SELECT
+  COUNT(*) FILTER (WHERE day >= $1::date),
+  COUNT(*) FILTER (WHERE day < $1::date)
+FROM first_purchase`, query.From, query.To).Scan(&breakdown.NewCustomers, &breakdown.ReturningCustomers); err != nil {
+		return nil, err
+	}
+	return &breakdown, nil
+}
+
+func NewReportRepository(dbWrite postgres.Writer, dbRead postgres.Reader) ReportRepository {
+	return &reportRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
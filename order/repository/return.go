@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+type ReturnRepository interface {
+	CreateReturns(ctx context.Context, returns []*domain.OrderReturn) error
+	GetReturnById(ctx context.Context, id string) (*domain.OrderReturn, error)
+	GetReturnsForOrder(ctx context.Context, orderId string) ([]*domain.OrderReturn, error)
+	// UpdateReturn stores orderReturn if its stored status is still from,
+	// and fails with ErrNoRows otherwise.
+	UpdateReturn(ctx context.Context, orderReturn *domain.OrderReturn, from string) error
+}
+
+type returnRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+func (r *returnRepository) CreateReturns(ctx context.Context, returns []*domain.OrderReturn) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := r.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, ret := range returns {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO order_return(id, order_id, catalog_id, quantity, restocked_quantity, reason, status, refund_amount, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			ret.Id,
+			ret.OrderId,
+			ret.CatalogId,
+			ret.Quantity,
+			ret.RestockedQuantity,
+			ret.Reason,
+			ret.Status,
+			ret.RefundAmount,
+			ret.CreatedAt,
+			ret.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *returnRepository) GetReturnById(ctx context.Context, id string) (*domain.OrderReturn, error) {
+	query := `
+SELECT id, order_id, catalog_id, quantity, restocked_quantity, reason, status, refund_amount::float8, created_at, updated_at
+FROM order_return
+WHERE id = $1`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	ret, err := scanReturn(r.dbRead.QueryRowContext(ctx, query, id))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoRows
+		default:
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (r *returnRepository) GetReturnsForOrder(ctx context.Context, orderId string) ([]*domain.OrderReturn, error) {
+	query := `
+SELECT id, order_id, catalog_id, quantity, restocked_quantity, reason, status, refund_amount::float8, created_at, updated_at
+FROM order_return
+WHERE order_id = $1
+ORDER BY created_at, id`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rows, err := r.dbRead.QueryContext(ctx, query, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	returns := make([]*domain.OrderReturn, 0)
+	for rows.Next() {
+		ret, err := scanReturn(rows)
+		if err != nil {
+			return nil, err
+		}
+		returns = append(returns, ret)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+func (r *returnRepository) UpdateReturn(ctx context.Context, orderReturn *domain.OrderReturn, from string) error {
+	query := `UPDATE order_return SET restocked_quantity=$2, reason=$3, status=$4, refund_amount=$5, updated_at=$6 WHERE id=$1 AND status=$7`
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	res, err := r.dbWrite.ExecContext(ctx, query,
+		orderReturn.Id,
+		orderReturn.RestockedQuantity,
+		orderReturn.Reason,
+		orderReturn.Status,
+		orderReturn.RefundAmount,
+		orderReturn.UpdatedAt,
+		from,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNoRows
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanReturn(row rowScanner) (*domain.OrderReturn, error) {
+	var ret domain.OrderReturn
+	if err := row.Scan(
+		&ret.Id,
+		&ret.OrderId,
+		&ret.CatalogId,
+		&ret.Quantity,
+		&ret.RestockedQuantity,
+		&ret.Reason,
+		&ret.Status,
+		&ret.RefundAmount,
+		&ret.CreatedAt,
+		&ret.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func NewReturnRepository(dbWrite postgres.Writer, dbRead postgres.Reader) ReturnRepository {
+	return &returnRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"time"
+)
+
+type ShipmentRepository interface {
+	CreateShipment(ctx context.Context, shipment *domain.Shipment) error
+	GetShipmentById(ctx context.Context, id string) (*domain.Shipment, error)
+	GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error)
+	AddShipmentEvent(ctx context.Context, shipmentId string, event *domain.ShipmentEvent) error
+}
+
+type shipmentRepository struct {
+	dbWrite postgres.Writer
+	dbRead  postgres.Reader
+}
+
+func (s *shipmentRepository) CreateShipment(ctx context.Context, shipment *domain.Shipment) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := s.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO shipment(id, order_id, carrier, tracking_number, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		shipment.Id,
+		shipment.OrderId,
+		shipment.Carrier,
+		shipment.TrackingNumber,
+		shipment.Status,
+		shipment.CreatedAt,
+		shipment.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range shipment.Lines {
+		_, err = tx.ExecContext(ctx, `INSERT INTO shipment_line(shipment_id, catalog_id, quantity) VALUES ($1, $2, $3)`, shipment.Id, line.CatalogId, line.Quantity)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, event := range shipment.Events {
+		if err = insertEvent(ctx, tx, shipment.Id, event); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *shipmentRepository) GetShipmentById(ctx context.Context, id string) (*domain.Shipment, error) {
+	shipments, err := s.getShipments(ctx, `WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(shipments) == 0 {
+		return nil, ErrNoRows
+	}
+	return shipments[0], nil
+}
+
+func (s *shipmentRepository) GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error) {
+	return s.getShipments(ctx, `WHERE order_id = $1`, orderId)
+}
+
+// AddShipmentEvent appends a tracking event and moves the shipment to the
+// event's status.
+func (s *shipmentRepository) AddShipmentEvent(ctx context.Context, shipmentId string, event *domain.ShipmentEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	tx, err := s.dbWrite.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, `UPDATE shipment SET status=$2, updated_at=$3 WHERE id=$1`, shipmentId, event.Status, event.OccurredAt)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		err = ErrNoRows
+		return err
+	}
+
+	if err = insertEvent(ctx, tx, shipmentId, event); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// getShipments loads the shipments matching the where clause together with
+// their lines and events.
+func (s *shipmentRepository) getShipments(ctx context.Context, where string, arg string) ([]*domain.Shipment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rows, err := s.dbRead.QueryContext(ctx, `
+SELECT id, order_id, carrier, tracking_number, status, created_at, updated_at
+FROM shipment
+`+where+`
+ORDER BY created_at, id`, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shipments := make([]*domain.Shipment, 0)
+	byId := make(map[string]*domain.Shipment)
+	var ids []string
+	for rows.Next() {
+		var shipment domain.Shipment
+		if err := rows.Scan(&shipment.Id, &shipment.OrderId, &shipment.Carrier, &shipment.TrackingNumber, &shipment.Status, &shipment.CreatedAt, &shipment.UpdatedAt); err != nil {
+			return nil, err
+		}
+		shipment.Lines = make([]*domain.ShipmentLine, 0)
+		shipment.Events = make([]*domain.ShipmentEvent, 0)
+		shipments = append(shipments, &shipment)
+		byId[shipment.Id] = &shipment
+		ids = append(ids, shipment.Id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return shipments, nil
+	}
+
+	lineRows, err := s.dbRead.QueryContext(ctx, `
+SELECT shipment_id, catalog_id, quantity
+FROM shipment_line
+WHERE shipment_id = ANY($1)
+ORDER BY shipment_id, catalog_id`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer lineRows.Close()
+
+	for lineRows.Next() {
+		var shipmentId string
+		var line domain.ShipmentLine
+		if err := lineRows.Scan(&shipmentId, &line.CatalogId, &line.Quantity); err != nil {
+			return nil, err
+		}
+		byId[shipmentId].Lines = append(byId[shipmentId].Lines, &line)
+	}
+	if err := lineRows.Err(); err != nil {
+		return nil, err
+	}
+
+	eventRows, err := s.dbRead.QueryContext(ctx, `
+SELECT shipment_id, status, description, location, occurred_at
+FROM shipment_event
+WHERE shipment_id = ANY($1)
+ORDER BY shipment_id, occurred_at, id`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer eventRows.Close()
+
+	for eventRows.Next() {
+		var shipmentId string
+		var event domain.ShipmentEvent
+		if err := eventRows.Scan(&shipmentId, &event.Status, &event.Description, &event.Location, &event.OccurredAt); err != nil {
+			return nil, err
+		}
+		byId[shipmentId].Events = append(byId[shipmentId].Events, &event)
+	}
+	if err := eventRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shipments, nil
+}
+
+func insertEvent(ctx context.Context, tx *sql.Tx, shipmentId string, event *domain.ShipmentEvent) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO shipment_event(shipment_id, status, description, location, occurred_at) VALUES ($1, $2, $3, $4, $5)`,
+		shipmentId,
+		event.Status,
+		event.Description,
+		event.Location,
+		event.OccurredAt,
+	)
+	return err
+}
+
+func NewShipmentRepository(dbWrite postgres.Writer, dbRead postgres.Reader) ShipmentRepository {
+	return &shipmentRepository{
+		dbWrite: dbWrite,
+		dbRead:  dbRead,
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/invoice"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/segmentio/ksuid"
+	"math"
+	"time"
+)
+
+var ErrOrderNotInvoiceable = errors.New("order has not been paid")
+
+// invoiceableStatuses are the order statuses reached only after a captured
+// payment, i.e. the orders an invoice is owed for.
+var invoiceableStatuses = map[string]bool{
+	domain.OrderStatusPaid:              true,
+	domain.OrderStatusPartiallyRefunded: true,
+	domain.OrderStatusRefunded:          true,
+}
+
+type InvoiceService interface {
+	IssueInvoice(ctx context.Context, orderId string) (*domain.Invoice, error)
+	GetInvoice(ctx context.Context, input *dto.GetInvoice) (*domain.InvoiceDocument, error)
+	GetInvoiceForOrder(ctx context.Context, orderId string) (*domain.Invoice, error)
+}
+
+type invoiceService struct {
+	sellerName        string
+	taxRate           float64
+	renderer          invoice.Renderer
+	orderRepository   repository.OrderRepository
+	invoiceRepository repository.InvoiceRepository
+}
+
+// IssueInvoice freezes the paid order into an invoice with the next number
+// of the year. Issuing is idempotent: an order keeps its first invoice.
+func (i *invoiceService) IssueInvoice(ctx context.Context, orderId string) (*domain.Invoice, error) {
+	order, err := i.orderRepository.GetOrderById(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if !invoiceableStatuses[order.Status] {
+		return nil, ErrOrderNotInvoiceable
+	}
+
+	inv := &domain.Invoice{
+		Id:             ksuid.New().String(),
+		OrderId:        order.Id,
+		AccountId:      order.AccountId,
+		SellerName:     i.sellerName,
+		BillingAddress: order.ShippingAddress,
+		TaxRate:        i.taxRate,
+		IssuedAt:       time.Now().UTC(),
+	}
+	for _, c := range order.Catalogs {
+		total := roundCents(c.Price * float64(c.Quantity))
+		net := roundCents(total / (1 + i.taxRate))
+		line := &domain.InvoiceLine{
+			CatalogId: c.Id,
+			Name:      c.Name,
+			Quantity:  c.Quantity,
+			UnitPrice: c.Price,
+			Net:       net,
+			Tax:       roundCents(total - net),
+			Total:     total,
+		}
+		inv.Lines = append(inv.Lines, line)
+		inv.Subtotal += line.Net
+		inv.Tax += line.Tax
+		inv.Total += line.Total
+	}
+	inv.Subtotal = roundCents(inv.Subtotal)
+	inv.Tax = roundCents(inv.Tax)
+	inv.Total = roundCents(inv.Total)
+
+	err = i.invoiceRepository.CreateInvoice(ctx, inv)
+	if errors.Is(err, repository.ErrInvoiceExists) {
+		return i.invoiceRepository.GetInvoiceForOrder(ctx, orderId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// GetInvoice renders an invoice. When an account id is given the invoice
+// must belong to it; invoices of other accounts are reported as not found.
+func (i *invoiceService) GetInvoice(ctx context.Context, input *dto.GetInvoice) (*domain.InvoiceDocument, error) {
+	inv, err := i.invoiceRepository.GetInvoiceById(ctx, input.InvoiceId)
+	if err != nil {
+		return nil, err
+	}
+	if input.AccountId != "" && inv.AccountId != input.AccountId {
+		return nil, repository.ErrNoRows
+	}
+	return i.renderer.Render(inv, input.Format)
+}
+
+// GetInvoiceForOrder returns the order's invoice, issuing it first if the
+// order was paid but issuing failed at capture time.
+func (i *invoiceService) GetInvoiceForOrder(ctx context.Context, orderId string) (*domain.Invoice, error) {
+	inv, err := i.invoiceRepository.GetInvoiceForOrder(ctx, orderId)
+	if !errors.Is(err, repository.ErrNoRows) {
+		return inv, err
+	}
+	return i.IssueInvoice(ctx, orderId)
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func NewInvoiceService(sellerName string, taxRate float64, renderer invoice.Renderer, orderRepository repository.OrderRepository, invoiceRepository repository.InvoiceRepository) InvoiceService {
+	return &invoiceService{
+		sellerName:        sellerName,
+		taxRate:           taxRate,
+		renderer:          renderer,
+		orderRepository:   orderRepository,
+		invoiceRepository: invoiceRepository,
+	}
+}
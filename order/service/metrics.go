@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	ordersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_created_total",
+		Help: "Orders placed.",
+	})
+	ordersCancelled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_cancelled_total",
+		Help: "Orders cancelled before shipping.",
+	})
+	revenueCaptured = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_revenue_captured_total",
+		Help: "Amount captured from customers, by payment provider.",
+	}, []string{"provider"})
+	revenueRefunded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_revenue_refunded_total",
+		Help: "Amount refunded to customers, by payment provider.",
+	}, []string{"provider"})
+)
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
 	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
@@ -9,21 +10,55 @@ import (
 	"time"
 )
 
+var (
+	ErrOrderNotCancellable = errors.New("order can no longer be cancelled")
+	ErrInvalidOrderQuery   = errors.New("invalid input: date and total ranges must not be inverted")
+	ErrInvalidSort         = errors.New("invalid input: sort must be asc or desc")
+	ErrTooManyIds          = errors.New("invalid input: too many IDs")
+)
+
+const (
+	defaultOrderPageSize = 20
+	maxOrderPageSize     = 100
+)
+
 type OrderService interface {
 	CreateOrder(ctx context.Context, input *dto.Order) (*domain.Order, error)
-	GetOrdersForAccount(ctx context.Context, accountId string) ([]*domain.Order, error)
+	GetOrderById(ctx context.Context, id string) (*domain.Order, error)
+	GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error)
+	GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, string, error)
+	CancelOrder(ctx context.Context, id string) (*domain.Order, error)
 }
 
 type orderService struct {
-	orderRepository repository.OrderRepository
+	orderRepository    repository.OrderRepository
+	shipmentRepository repository.ShipmentRepository
+	paymentService     PaymentService
 }
 
+// CreateOrder places an order. When input carries the checkout key of an
+// order placed before, that order is returned instead, so that a checkout
+// can be retried without ordering twice.
 func (o *orderService) CreateOrder(ctx context.Context, input *dto.Order) (*domain.Order, error) {
 	order := &domain.Order{
-		Id:        ksuid.New().String(),
-		CreatedAt: time.Now().UTC(),
-		AccountId: input.AccountId,
-		Catalogs:  make([]*domain.OrderedCatalog, len(input.Catalogs)),
+		Id:          ksuid.New().String(),
+		CreatedAt:   time.Now().UTC(),
+		AccountId:   input.AccountId,
+		Status:      domain.OrderStatusPending,
+		CheckoutKey: input.CheckoutKey,
+		Catalogs:    make([]*domain.OrderedCatalog, len(input.Catalogs)),
+	}
+	if a := input.ShippingAddress; a != nil {
+		order.ShippingAddress = &domain.ShippingAddress{
+			Recipient:  a.Recipient,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+		}
 	}
 
 	order.TotalPrice = 0.0
@@ -38,18 +73,107 @@ func (o *orderService) CreateOrder(ctx context.Context, input *dto.Order) (*doma
 		}
 	}
 
-	if err := o.orderRepository.CreateOrder(ctx, order); err != nil {
+	err := o.orderRepository.CreateOrder(ctx, order)
+	if errors.Is(err, repository.ErrCheckedOut) {
+		return o.orderRepository.GetOrderById(ctx, order.Id)
+	}
+	if err != nil {
 		return nil, err
 	}
+	ordersCreated.Inc()
 	return order, nil
 }
 
-func (o *orderService) GetOrdersForAccount(ctx context.Context, accountId string) ([]*domain.Order, error) {
-	return o.orderRepository.GetOrdersForAccount(ctx, accountId)
+func (o *orderService) GetOrderById(ctx context.Context, id string) (*domain.Order, error) {
+	return o.orderRepository.GetOrderById(ctx, id)
+}
+
+func (o *orderService) GetOrdersByIds(ctx context.Context, ids []string) ([]*domain.Order, error) {
+	if len(ids) == 0 {
+		return []*domain.Order{}, nil
+	}
+	if len(ids) > maxOrderPageSize {
+		return nil, ErrTooManyIds
+	}
+	return o.orderRepository.GetOrdersByIds(ctx, ids)
+}
+
+// GetOrdersForAccount returns a page of the account's orders, newest first
+// unless sorted asc, and the cursor of the next page, which is empty on the
+// last page.
+func (o *orderService) GetOrdersForAccount(ctx context.Context, query *dto.OrderQuery) ([]*domain.Order, string, error) {
+	switch query.Sort {
+	case "":
+		query.Sort = dto.SortDesc
+	case dto.SortAsc, dto.SortDesc:
+	default:
+		return nil, "", ErrInvalidSort
+	}
+	if !query.CreatedFrom.IsZero() && !query.CreatedTo.IsZero() && !query.CreatedFrom.Before(query.CreatedTo) {
+		return nil, "", ErrInvalidOrderQuery
+	}
+	if query.MinTotal != nil && query.MaxTotal != nil && *query.MinTotal > *query.MaxTotal {
+		return nil, "", ErrInvalidOrderQuery
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultOrderPageSize
+	}
+	if query.Limit > maxOrderPageSize {
+		query.Limit = maxOrderPageSize
+	}
+
+	// Fetch one extra order to learn whether another page follows.
+	limit := query.Limit
+	query.Limit++
+	orders, err := o.orderRepository.GetOrdersForAccount(ctx, query)
+	query.Limit = limit
+	if err != nil {
+		return nil, "", err
+	}
+
+	if uint64(len(orders)) <= limit {
+		return orders, "", nil
+	}
+	orders = orders[:limit]
+	last := orders[len(orders)-1]
+	return orders, dto.EncodeOrderCursor(last.CreatedAt, last.Id), nil
+}
+
+// CancelOrder cancels an order that has not been shipped yet, voiding or
+// refunding its payment first.
+func (o *orderService) CancelOrder(ctx context.Context, id string) (*domain.Order, error) {
+	order, err := o.orderRepository.GetOrderById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusPending && order.Status != domain.OrderStatusPaid {
+		return nil, ErrOrderNotCancellable
+	}
+
+	shipments, err := o.shipmentRepository.GetShipmentsForOrder(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(shipments) > 0 {
+		return nil, ErrOrderNotCancellable
+	}
+
+	if err := o.paymentService.ReleaseOrder(ctx, order.Id); err != nil {
+		return nil, err
+	}
+
+	if err := o.orderRepository.UpdateOrderStatus(ctx, order.Id, domain.OrderStatusCancelled); err != nil {
+		return nil, err
+	}
+	order.Status = domain.OrderStatusCancelled
+	ordersCancelled.Inc()
+	return order, nil
 }
 
-func NewOrderService(orderRepository repository.OrderRepository) OrderService {
+func NewOrderService(orderRepository repository.OrderRepository, shipmentRepository repository.ShipmentRepository, paymentService PaymentService) OrderService {
 	return &orderService{
-		orderRepository: orderRepository,
+		orderRepository:    orderRepository,
+		shipmentRepository: shipmentRepository,
+		paymentService:     paymentService,
 	}
 }
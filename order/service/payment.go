@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/payment"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/segmentio/ksuid"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrOrderNotPayable      = errors.New("order is not awaiting payment")
+	ErrPaymentNotRefundable = errors.New("order has no captured payment to refund")
+	ErrInvalidRefundAmount  = errors.New("invalid input: refund amount exceeds the refundable amount")
+	ErrUnknownProvider      = errors.New("unknown payment provider")
+)
+
+type PaymentService interface {
+	PayOrder(ctx context.Context, input *dto.PayOrder) (*domain.Payment, error)
+	RefundOrder(ctx context.Context, input *dto.RefundOrder) (*domain.Payment, error)
+	HandleWebhook(ctx context.Context, input *dto.PaymentWebhook) error
+	ReleaseOrder(ctx context.Context, orderId string) error
+}
+
+type paymentService struct {
+	provider          payment.PaymentProvider
+	orderRepository   repository.OrderRepository
+	paymentRepository repository.PaymentRepository
+	invoiceService    InvoiceService
+}
+
+// PayOrder authorizes the order total with the provider, records the payment
+// intent and captures it. Providers that confirm captures asynchronously leave
+// the payment authorized until their webhook arrives.
+func (p *paymentService) PayOrder(ctx context.Context, input *dto.PayOrder) (*domain.Payment, error) {
+	order, err := p.orderRepository.GetOrderById(ctx, input.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusPending {
+		return nil, ErrOrderNotPayable
+	}
+
+	paymentId := ksuid.New().String()
+	auth, err := p.provider.Authorize(ctx, &payment.AuthorizeInput{
+		PaymentId:     paymentId,
+		OrderId:       order.Id,
+		Amount:        order.TotalPrice,
+		PaymentMethod: input.PaymentMethod,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("payment authorization failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	pay := &domain.Payment{
+		Id:                paymentId,
+		OrderId:           order.Id,
+		Provider:          p.provider.Name(),
+		ProviderReference: auth.Reference,
+		Amount:            order.TotalPrice,
+		Status:            domain.PaymentStatusAuthorized,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if err := p.paymentRepository.CreatePayment(ctx, pay); err != nil {
+		// Another call paid the order since it was read; its payment stands
+		// and this authorization is given back.
+		if _, voidErr := p.provider.Void(ctx, pay.ProviderReference); voidErr != nil {
+			slog.Error("payment.void.failed", slog.String("order_id", order.Id), slog.String("reference", pay.ProviderReference), slog.String("error", voidErr.Error()))
+		}
+		if errors.Is(err, repository.ErrPaymentExists) {
+			return nil, ErrOrderNotPayable
+		}
+		return nil, err
+	}
+
+	capture, err := p.provider.Capture(ctx, pay.ProviderReference, pay.Amount)
+	if err != nil {
+		if _, voidErr := p.provider.Void(ctx, pay.ProviderReference); voidErr == nil {
+			pay.Status = domain.PaymentStatusVoided
+		} else {
+			pay.Status = domain.PaymentStatusFailed
+		}
+		pay.UpdatedAt = time.Now().UTC()
+		if updateErr := p.paymentRepository.UpdatePayment(ctx, pay); updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, fmt.Errorf("payment capture failed: %w", err)
+	}
+
+	if capture.Status == payment.StatusSucceeded {
+		if err := p.markCaptured(ctx, pay); err != nil {
+			return nil, err
+		}
+	}
+	return pay, nil
+}
+
+// RefundOrder refunds the given amount of the order's captured payment, or
+// everything still refundable when the amount is zero. The amount is added
+// to the payment's refunded total before the provider is asked, so
+// concurrent refunds can't take more than was captured between them, and
+// taken off again if the provider refuses.
+func (p *paymentService) RefundOrder(ctx context.Context, input *dto.RefundOrder) (*domain.Payment, error) {
+	pay, err := p.paymentRepository.GetPaymentForOrder(ctx, input.OrderId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRows) {
+			return nil, ErrPaymentNotRefundable
+		}
+		return nil, err
+	}
+	if pay.Status != domain.PaymentStatusCaptured && pay.Status != domain.PaymentStatusPartiallyRefunded {
+		return nil, ErrPaymentNotRefundable
+	}
+
+	amount := input.Amount
+	if amount == 0 {
+		amount = pay.Amount - pay.RefundedAmount
+	}
+	if amount <= 0 {
+		return nil, ErrInvalidRefundAmount
+	}
+
+	refunded, err := p.paymentRepository.AddRefund(ctx, pay.Id, amount)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRows) {
+			return nil, ErrInvalidRefundAmount
+		}
+		return nil, err
+	}
+
+	if _, err := p.provider.Refund(ctx, pay.ProviderReference, amount); err != nil {
+		if _, undoErr := p.paymentRepository.AddRefund(ctx, pay.Id, -amount); undoErr != nil {
+			slog.Error("payment.refund.undo.failed", slog.String("payment_id", pay.Id), slog.String("error", undoErr.Error()))
+		}
+		return nil, fmt.Errorf("payment refund failed: %w", err)
+	}
+
+	if err := p.markRefunded(ctx, refunded, amount); err != nil {
+		return nil, err
+	}
+	return refunded, nil
+}
+
+// HandleWebhook applies a provider callback to the payment it references.
+// Callbacks are idempotent: replaying an event leaves the payment unchanged.
+func (p *paymentService) HandleWebhook(ctx context.Context, input *dto.PaymentWebhook) error {
+	if input.Provider != p.provider.Name() {
+		return ErrUnknownProvider
+	}
+
+	event, err := p.provider.ParseWebhook(input.Payload, input.Signature)
+	if err != nil {
+		return err
+	}
+
+	pay, err := p.paymentRepository.GetPaymentByReference(ctx, input.Provider, event.Reference)
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case payment.EventPaymentCaptured:
+		if pay.Status != domain.PaymentStatusAuthorized {
+			return nil
+		}
+		return p.markCaptured(ctx, pay)
+	case payment.EventPaymentFailed, payment.EventPaymentVoided:
+		if pay.Status != domain.PaymentStatusAuthorized {
+			return nil
+		}
+		pay.Status = domain.PaymentStatusFailed
+		if event.Type == payment.EventPaymentVoided {
+			pay.Status = domain.PaymentStatusVoided
+		}
+		pay.UpdatedAt = time.Now().UTC()
+		return p.paymentRepository.UpdatePayment(ctx, pay)
+	case payment.EventRefundSucceeded:
+		// Refund events carry the total refunded so far, which only counts
+		// when it is more than the payment holds: refunds made here are
+		// counted before the provider is asked.
+		if event.Amount <= pay.RefundedAmount || event.Amount > pay.Amount {
+			return nil
+		}
+		refunded, err := p.paymentRepository.RaiseRefund(ctx, pay.Id, event.Amount)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		if refunded.RefundedAmount <= pay.RefundedAmount {
+			return nil
+		}
+		return p.markRefunded(ctx, refunded, refunded.RefundedAmount-pay.RefundedAmount)
+	default:
+		return fmt.Errorf("unsupported payment event %q", event.Type)
+	}
+}
+
+// ReleaseOrder gives back whatever the order holds with the provider: an
+// authorization is voided and a captured payment is refunded in full.
+func (p *paymentService) ReleaseOrder(ctx context.Context, orderId string) error {
+	pay, err := p.paymentRepository.GetPaymentForOrder(ctx, orderId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	switch pay.Status {
+	case domain.PaymentStatusAuthorized:
+		if _, err := p.provider.Void(ctx, pay.ProviderReference); err != nil {
+			return fmt.Errorf("payment void failed: %w", err)
+		}
+		pay.Status = domain.PaymentStatusVoided
+		pay.UpdatedAt = time.Now().UTC()
+		return p.paymentRepository.UpdatePayment(ctx, pay)
+	case domain.PaymentStatusCaptured, domain.PaymentStatusPartiallyRefunded:
+		_, err := p.RefundOrder(ctx, &dto.RefundOrder{OrderId: orderId})
+		return err
+	default:
+		return nil
+	}
+}
+
+func (p *paymentService) markCaptured(ctx context.Context, pay *domain.Payment) error {
+	pay.Status = domain.PaymentStatusCaptured
+	pay.UpdatedAt = time.Now().UTC()
+	if err := p.paymentRepository.UpdatePayment(ctx, pay); err != nil {
+		return err
+	}
+	if err := p.orderRepository.UpdateOrderStatus(ctx, pay.OrderId, domain.OrderStatusPaid); err != nil {
+		return err
+	}
+	revenueCaptured.WithLabelValues(pay.Provider).Add(pay.Amount)
+	// The money is already captured, so a failed invoice must not fail the
+	// payment; GetInvoiceForOrder issues it on first request instead.
+	if _, err := p.invoiceService.IssueInvoice(ctx, pay.OrderId); err != nil {
+		slog.Error("invoice.issue.failed", slog.String("order_id", pay.OrderId), slog.String("error", err.Error()))
+	}
+	return nil
+}
+
+// markRefunded carries the status of pay, which has just refunded delta more,
+// over to its order.
+func (p *paymentService) markRefunded(ctx context.Context, pay *domain.Payment, delta float64) error {
+	orderStatus := domain.OrderStatusPartiallyRefunded
+	if pay.Status == domain.PaymentStatusRefunded {
+		orderStatus = domain.OrderStatusRefunded
+	}
+	if err := p.orderRepository.UpdateOrderStatus(ctx, pay.OrderId, orderStatus); err != nil {
+		return err
+	}
+	revenueRefunded.WithLabelValues(pay.Provider).Add(delta)
+	return nil
+}
+
+func NewPaymentService(provider payment.PaymentProvider, orderRepository repository.OrderRepository, paymentRepository repository.PaymentRepository, invoiceService InvoiceService) PaymentService {
+	return &paymentService{
+		provider:          provider,
+		orderRepository:   orderRepository,
+		paymentRepository: paymentRepository,
+		invoiceService:    invoiceService,
+	}
+}
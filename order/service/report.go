@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"time"
+)
+
+var (
+	ErrInvalidReportRange = errors.New("invalid input: report range must start before it ends")
+	ErrInvalidGranularity = errors.New("invalid input: granularity must be day, week or month")
+	ErrInvalidProductRank = errors.New("invalid input: products can be ranked by quantity or revenue")
+)
+
+const (
+	defaultTopProductCount = 10
+	maxTopProductCount     = 100
+)
+
+type ReportService interface {
+	RefreshAggregates(ctx context.Context) error
+	GetRevenue(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.RevenueBucket, error)
+	GetTopProducts(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.ProductSales, error)
+	GetSalesSummary(ctx context.Context, query *dto.SalesReportQuery) (*domain.SalesSummary, error)
+	GetCustomerBreakdown(ctx context.Context, query *dto.SalesReportQuery) (*domain.CustomerBreakdown, error)
+}
+
+type reportService struct {
+	reportRepository repository.ReportRepository
+}
+
+func (r *reportService) RefreshAggregates(ctx context.Context) error {
+	return r.reportRepository.RefreshSalesAggregates(ctx)
+}
+
+func (r *reportService) GetRevenue(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.RevenueBucket, error) {
+	if err := normalizeRange(query); err != nil {
+		return nil, err
+	}
+	switch query.Granularity {
+	case "":
+		query.Granularity = domain.ReportGranularityDay
+	case domain.ReportGranularityDay, domain.ReportGranularityWeek, domain.ReportGranularityMonth:
+	default:
+		return nil, ErrInvalidGranularity
+	}
+	return r.reportRepository.GetRevenue(ctx, query)
+}
+
+func (r *reportService) GetTopProducts(ctx context.Context, query *dto.SalesReportQuery) ([]*domain.ProductSales, error) {
+	if err := normalizeRange(query); err != nil {
+		return nil, err
+	}
+	switch query.RankBy {
+	case "":
+		query.RankBy = domain.ProductRankingQuantity
+	case domain.ProductRankingQuantity, domain.ProductRankingRevenue:
+	default:
+		return nil, ErrInvalidProductRank
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultTopProductCount
+	}
+	if query.Limit > maxTopProductCount {
+		query.Limit = maxTopProductCount
+	}
+	return r.reportRepository.GetTopProducts(ctx, query)
+}
+
+func (r *reportService) GetSalesSummary(ctx context.Context, query *dto.SalesReportQuery) (*domain.SalesSummary, error) {
+	if err := normalizeRange(query); err != nil {
+		return nil, err
+	}
+	summary, err := r.reportRepository.GetSalesSummary(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if summary.OrderCount > 0 {
+		summary.AverageOrderValue = summary.Revenue / float64(summary.OrderCount)
+	}
+	return summary, nil
+}
+
+func (r *reportService) GetCustomerBreakdown(ctx context.Context, query *dto.SalesReportQuery) (*domain.CustomerBreakdown, error) {
+	if err := normalizeRange(query); err != nil {
+		return nil, err
+	}
+	return r.reportRepository.GetCustomerBreakdown(ctx, query)
+}
+
+// normalizeRange truncates the range to whole UTC days, the resolution of the
+// sales aggregates. The end day is exclusive.
+func normalizeRange(query *dto.SalesReportQuery) error {
+	if query.From.IsZero() || query.To.IsZero() {
+		return ErrInvalidReportRange
+	}
+	query.From = query.From.UTC().Truncate(24 * time.Hour)
+	query.To = query.To.UTC().Truncate(24 * time.Hour)
+	if !query.From.Before(query.To) {
+		return ErrInvalidReportRange
+	}
+	return nil
+}
+
+func NewReportService(reportRepository repository.ReportRepository) ReportService {
+	return &reportService{
+		reportRepository: reportRepository,
+	}
+}
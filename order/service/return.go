@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/segmentio/ksuid"
+	"time"
+)
+
+var (
+	ErrOrderNotReturnable     = errors.New("order is not eligible for returns")
+	ErrInvalidReturnQuantity  = errors.New("invalid input: return quantity exceeds the ordered quantity")
+	ErrInvalidReturnLine      = errors.New("invalid input: product is not part of the order")
+	ErrInvalidReturnLineCount = errors.New("invalid input: at least one return line is required")
+	ErrReturnStatus           = errors.New("return is not in a state that allows this operation")
+)
+
+type ReturnService interface {
+	RequestReturn(ctx context.Context, input *dto.RequestReturn) ([]*domain.OrderReturn, error)
+	ApproveReturn(ctx context.Context, id string) (*domain.OrderReturn, error)
+	RejectReturn(ctx context.Context, id string) (*domain.OrderReturn, error)
+	ReceiveReturn(ctx context.Context, id string) (*domain.OrderReturn, error)
+	GetReturnsForOrder(ctx context.Context, orderId string) ([]*domain.OrderReturn, error)
+}
+
+type returnService struct {
+	orderRepository  repository.OrderRepository
+	returnRepository repository.ReturnRepository
+	paymentService   PaymentService
+}
+
+// RequestReturn records one return per requested line. The refund of each
+// line is its ordered unit price times the returned quantity.
+func (r *returnService) RequestReturn(ctx context.Context, input *dto.RequestReturn) ([]*domain.OrderReturn, error) {
+	if len(input.Lines) == 0 {
+		return nil, ErrInvalidReturnLineCount
+	}
+
+	order, err := r.orderRepository.GetOrderById(ctx, input.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusPaid && order.Status != domain.OrderStatusPartiallyRefunded {
+		return nil, ErrOrderNotReturnable
+	}
+
+	existing, err := r.returnRepository.GetReturnsForOrder(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+	returned := make(map[string]uint32)
+	for _, ret := range existing {
+		if ret.Status != domain.ReturnStatusRejected {
+			returned[ret.CatalogId] += ret.Quantity
+		}
+	}
+
+	now := time.Now().UTC()
+	returns := make([]*domain.OrderReturn, 0, len(input.Lines))
+	for _, line := range input.Lines {
+		var ordered *domain.OrderedCatalog
+		for _, c := range order.Catalogs {
+			if c.Id == line.CatalogId {
+				ordered = c
+				break
+			}
+		}
+		if ordered == nil {
+			return nil, ErrInvalidReturnLine
+		}
+		if line.Quantity == 0 || returned[line.CatalogId]+line.Quantity > ordered.Quantity {
+			return nil, ErrInvalidReturnQuantity
+		}
+		returned[line.CatalogId] += line.Quantity
+
+		returns = append(returns, &domain.OrderReturn{
+			Id:           ksuid.New().String(),
+			OrderId:      order.Id,
+			CatalogId:    line.CatalogId,
+			Quantity:     line.Quantity,
+			Reason:       input.Reason,
+			Status:       domain.ReturnStatusRequested,
+			RefundAmount: ordered.Price * float64(line.Quantity),
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+	}
+
+	if err := r.returnRepository.CreateReturns(ctx, returns); err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+func (r *returnService) ApproveReturn(ctx context.Context, id string) (*domain.OrderReturn, error) {
+	return r.transition(ctx, id, domain.ReturnStatusRequested, domain.ReturnStatusApproved)
+}
+
+func (r *returnService) RejectReturn(ctx context.Context, id string) (*domain.OrderReturn, error) {
+	return r.transition(ctx, id, domain.ReturnStatusRequested, domain.ReturnStatusRejected)
+}
+
+// ReceiveReturn records the returned items as restocked and refunds the line.
+// The return is moved to refunding before the refund, which only one call
+// can do, and back to received if the refund fails, so that it can be
+// retried. A return left refunding by a failure after the refund was made is
+// never refunded again.
+func (r *returnService) ReceiveReturn(ctx context.Context, id string) (*domain.OrderReturn, error) {
+	ret, err := r.returnRepository.GetReturnById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Status != domain.ReturnStatusApproved && ret.Status != domain.ReturnStatusReceived {
+		return nil, ErrReturnStatus
+	}
+
+	if err := r.update(ctx, ret, domain.ReturnStatusRefunding); err != nil {
+		return nil, err
+	}
+
+	if ret.RefundAmount > 0 {
+		if _, err := r.paymentService.RefundOrder(ctx, &dto.RefundOrder{
+			OrderId: ret.OrderId,
+			Amount:  ret.RefundAmount,
+		}); err != nil {
+			if updateErr := r.update(ctx, ret, domain.ReturnStatusReceived); updateErr != nil {
+				return nil, errors.Join(err, updateErr)
+			}
+			return nil, err
+		}
+	}
+
+	if err := r.update(ctx, ret, domain.ReturnStatusRefunded); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (r *returnService) GetReturnsForOrder(ctx context.Context, orderId string) ([]*domain.OrderReturn, error) {
+	return r.returnRepository.GetReturnsForOrder(ctx, orderId)
+}
+
+func (r *returnService) transition(ctx context.Context, id, from, to string) (*domain.OrderReturn, error) {
+	ret, err := r.returnRepository.GetReturnById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Status != from {
+		return nil, ErrReturnStatus
+	}
+	if err := r.update(ctx, ret, to); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// update moves ret to status, unless another call moved it first. Received
+// items are restocked.
+func (r *returnService) update(ctx context.Context, ret *domain.OrderReturn, status string) error {
+	from := ret.Status
+	ret.Status = status
+	if status == domain.ReturnStatusReceived || status == domain.ReturnStatusRefunding {
+		ret.RestockedQuantity = ret.Quantity
+	}
+	ret.UpdatedAt = time.Now().UTC()
+	if err := r.returnRepository.UpdateReturn(ctx, ret, from); err != nil {
+		ret.Status = from
+		if errors.Is(err, repository.ErrNoRows) {
+			return ErrReturnStatus
+		}
+		return err
+	}
+	return nil
+}
+
+func NewReturnService(orderRepository repository.OrderRepository, returnRepository repository.ReturnRepository, paymentService PaymentService) ReturnService {
+	return &returnService{
+		orderRepository:  orderRepository,
+		returnRepository: returnRepository,
+		paymentService:   paymentService,
+	}
+}
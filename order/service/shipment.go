@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/segmentio/ksuid"
+	"strings"
+	"time"
+)
+
+var (
+	ErrOrderNotShippable        = errors.New("order is not eligible for shipping")
+	ErrMissingShippingAddress   = errors.New("order has no shipping address")
+	ErrInvalidShipment          = errors.New("invalid input: carrier and tracking number are required")
+	ErrInvalidShipmentLineCount = errors.New("invalid input: at least one shipment line is required")
+	ErrInvalidShipmentLine      = errors.New("invalid input: product is not part of the order")
+	ErrDuplicateShipmentLine    = errors.New("invalid input: product appears on more than one shipment line")
+	ErrInvalidShipmentQuantity  = errors.New("invalid input: shipped quantity exceeds the ordered quantity")
+	ErrInvalidShipmentStatus    = errors.New("invalid input: unknown shipment status")
+	ErrShipmentStatus           = errors.New("shipment is not in a state that allows this operation")
+)
+
+// shipmentUpdateStatuses are the statuses a carrier update can move a shipment
+// to; label_created is only ever set when the shipment is created.
+var shipmentUpdateStatuses = map[string]bool{
+	domain.ShipmentStatusInTransit:      true,
+	domain.ShipmentStatusOutForDelivery: true,
+	domain.ShipmentStatusDelivered:      true,
+	domain.ShipmentStatusException:      true,
+}
+
+type ShipmentService interface {
+	CreateShipment(ctx context.Context, input *dto.CreateShipment) (*domain.Shipment, error)
+	UpdateShipmentStatus(ctx context.Context, input *dto.UpdateShipmentStatus) (*domain.Shipment, error)
+	GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error)
+}
+
+type shipmentService struct {
+	orderRepository    repository.OrderRepository
+	shipmentRepository repository.ShipmentRepository
+}
+
+// CreateShipment ships some or all of the remaining quantity of a paid
+// order's lines. An order can be split over any number of shipments.
+func (s *shipmentService) CreateShipment(ctx context.Context, input *dto.CreateShipment) (*domain.Shipment, error) {
+	if strings.TrimSpace(input.Carrier) == "" || strings.TrimSpace(input.TrackingNumber) == "" {
+		return nil, ErrInvalidShipment
+	}
+	if len(input.Lines) == 0 {
+		return nil, ErrInvalidShipmentLineCount
+	}
+	listed := make(map[string]bool, len(input.Lines))
+	for _, line := range input.Lines {
+		if listed[line.CatalogId] {
+			return nil, ErrDuplicateShipmentLine
+		}
+		listed[line.CatalogId] = true
+	}
+
+	order, err := s.orderRepository.GetOrderById(ctx, input.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusPaid && order.Status != domain.OrderStatusPartiallyRefunded {
+		return nil, ErrOrderNotShippable
+	}
+	if order.ShippingAddress == nil {
+		return nil, ErrMissingShippingAddress
+	}
+
+	existing, err := s.shipmentRepository.GetShipmentsForOrder(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+	shipped := make(map[string]uint32)
+	for _, shipment := range existing {
+		for _, line := range shipment.Lines {
+			shipped[line.CatalogId] += line.Quantity
+		}
+	}
+
+	lines := make([]*domain.ShipmentLine, 0, len(input.Lines))
+	for _, line := range input.Lines {
+		var ordered *domain.OrderedCatalog
+		for _, c := range order.Catalogs {
+			if c.Id == line.CatalogId {
+				ordered = c
+				break
+			}
+		}
+		if ordered == nil {
+			return nil, ErrInvalidShipmentLine
+		}
+		if line.Quantity == 0 || shipped[line.CatalogId]+line.Quantity > ordered.Quantity {
+			return nil, ErrInvalidShipmentQuantity
+		}
+		shipped[line.CatalogId] += line.Quantity
+
+		lines = append(lines, &domain.ShipmentLine{
+			CatalogId: line.CatalogId,
+			Quantity:  line.Quantity,
+		})
+	}
+
+	now := time.Now().UTC()
+	shipment := &domain.Shipment{
+		Id:             ksuid.New().String(),
+		OrderId:        order.Id,
+		Carrier:        strings.TrimSpace(input.Carrier),
+		TrackingNumber: strings.TrimSpace(input.TrackingNumber),
+		Status:         domain.ShipmentStatusLabelCreated,
+		Lines:          lines,
+		Events: []*domain.ShipmentEvent{{
+			Status:      domain.ShipmentStatusLabelCreated,
+			Description: "Shipping label created",
+			OccurredAt:  now,
+		}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.shipmentRepository.CreateShipment(ctx, shipment); err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+// UpdateShipmentStatus records a tracking event. Delivered shipments are
+// final.
+func (s *shipmentService) UpdateShipmentStatus(ctx context.Context, input *dto.UpdateShipmentStatus) (*domain.Shipment, error) {
+	if !shipmentUpdateStatuses[input.Status] {
+		return nil, ErrInvalidShipmentStatus
+	}
+
+	shipment, err := s.shipmentRepository.GetShipmentById(ctx, input.ShipmentId)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Status == domain.ShipmentStatusDelivered {
+		return nil, ErrShipmentStatus
+	}
+
+	event := &domain.ShipmentEvent{
+		Status:      input.Status,
+		Description: input.Description,
+		Location:    input.Location,
+		OccurredAt:  time.Now().UTC(),
+	}
+	if err := s.shipmentRepository.AddShipmentEvent(ctx, shipment.Id, event); err != nil {
+		return nil, err
+	}
+
+	shipment.Status = event.Status
+	shipment.UpdatedAt = event.OccurredAt
+	shipment.Events = append(shipment.Events, event)
+	return shipment, nil
+}
+
+func (s *shipmentService) GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error) {
+	return s.shipmentRepository.GetShipmentsForOrder(ctx, orderId)
+}
+
+func NewShipmentService(orderRepository repository.OrderRepository, shipmentRepository repository.ShipmentRepository) ShipmentService {
+	return &shipmentService{
+		orderRepository:    orderRepository,
+		shipmentRepository: shipmentRepository,
+	}
+}
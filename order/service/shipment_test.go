@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+)
+
+// paidOrders holds one paid order of three desks.
+type paidOrders struct {
+	repository.OrderRepository
+}
+
+func (paidOrders) GetOrderById(ctx context.Context, id string) (*domain.Order, error) {
+	return &domain.Order{
+		Id:              id,
+		Status:          domain.OrderStatusPaid,
+		ShippingAddress: &domain.ShippingAddress{Recipient: "Alice", Line1: "1 Main St", City: "Springfield", Country: "US"},
+		Catalogs:        []*domain.OrderedCatalog{{Id: "desk", Name: "Desk", Price: 120, Quantity: 3}},
+	}, nil
+}
+
+// recordedShipments has no shipments until CreateShipment stores one.
+type recordedShipments struct {
+	repository.ShipmentRepository
+	created []*domain.Shipment
+}
+
+func (r *recordedShipments) GetShipmentsForOrder(ctx context.Context, orderId string) ([]*domain.Shipment, error) {
+	return r.created, nil
+}
+
+func (r *recordedShipments) CreateShipment(ctx context.Context, shipment *domain.Shipment) error {
+	r.created = append(r.created, shipment)
+	return nil
+}
+
+// TestCreateShipmentRejectsDuplicateLines lists a product twice, in
+// quantities that fit the order together, so only the duplicate line is
+// wrong.
+func TestCreateShipmentRejectsDuplicateLines(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		lines       []*dto.ShipmentLine
+		wantErr     error
+		wantCreated int
+	}{
+		{"one line", []*dto.ShipmentLine{{CatalogId: "desk", Quantity: 2}}, nil, 1},
+		{"duplicate line", []*dto.ShipmentLine{{CatalogId: "desk", Quantity: 1}, {CatalogId: "desk", Quantity: 1}}, ErrDuplicateShipmentLine, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			shipments := &recordedShipments{}
+			s := NewShipmentService(paidOrders{}, shipments)
+
+			_, err := s.CreateShipment(t.Context(), &dto.CreateShipment{OrderId: "o1", Carrier: "UPS", TrackingNumber: "1Z999", Lines: tt.lines})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if len(shipments.created) != tt.wantCreated {
+				t.Errorf("%d shipments created, want %d", len(shipments.created), tt.wantCreated)
+			}
+		})
+	}
+}
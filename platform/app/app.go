@@ -0,0 +1,180 @@
+// Package app runs the lifecycle of a service: its components start in the
+// order they were added and stop in the reverse order, so that servers
+// drain before the connections they use are closed.
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Hook is one component of a service. Every function is optional.
+type Hook struct {
+	Name string
+	// Start prepares the component and returns once it is ready.
+	Start func(ctx context.Context) error
+	// Run does the work of the component, such as serving requests, until
+	// its Stop is called or ctx is done. An error shuts the service down.
+	Run func(ctx context.Context) error
+	// Stop releases the component within the shutdown timeout.
+	Stop func(ctx context.Context) error
+}
+
+// Closer is a hook that closes a resource when the service stops.
+func Closer(name string, close func() error) Hook {
+	return Hook{
+		Name: name,
+		Stop: func(context.Context) error {
+			return close()
+		},
+	}
+}
+
+type App struct {
+	name            string
+	shutdownTimeout time.Duration
+
+	mu    sync.Mutex
+	hooks []Hook
+}
+
+// Append adds hooks after those added so far.
+func (a *App) Append(hooks ...Hook) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.hooks = append(a.hooks, hooks...)
+}
+
+// Run starts every hook, waits for SIGINT, SIGTERM, the end of ctx or a
+// failing Run, and stops the hooks it started. It returns the error that
+// ended the service, if any.
+func (a *App) Run(ctx context.Context) error {
+	ctx, stopSignals := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+
+	a.mu.Lock()
+	hooks := a.hooks
+	a.mu.Unlock()
+
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	defer cancelRun()
+	runErrCh := make(chan error, len(hooks))
+	var running sync.WaitGroup
+
+	var err error
+	started := 0
+	for _, hook := range hooks {
+		if hook.Start != nil {
+			if err = hook.Start(ctx); err != nil {
+				err = fmt.Errorf("%s: %w", hook.Name, err)
+				slog.Error("app.start.failed", slog.String("service", a.name), slog.String("hook", hook.Name), slog.String("error", err.Error()))
+				break
+			}
+		}
+		started++
+		if hook.Run != nil {
+			running.Add(1)
+			go func() {
+				defer running.Done()
+				if runErr := hook.Run(runCtx); runErr != nil {
+					runErrCh <- fmt.Errorf("%s: %w", hook.Name, runErr)
+				}
+			}()
+		}
+	}
+
+	if err == nil {
+		slog.Info("app.started", slog.String("service", a.name))
+		select {
+		case <-ctx.Done():
+			slog.Info("shutdown.signal.received", slog.String("service", a.name))
+		case err = <-runErrCh:
+			slog.Error("app.run.failed", slog.String("service", a.name), slog.String("error", err.Error()))
+		}
+	}
+
+	slog.Info("shutdown.initiating", slog.String("service", a.name), slog.Duration("timeout", a.shutdownTimeout))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+
+	cancelRun()
+	for i := started - 1; i >= 0; i-- {
+		if stopErr := stop(shutdownCtx, hooks[i]); stopErr != nil {
+			slog.Error("app.stop.failed", slog.String("service", a.name), slog.String("hook", hooks[i].Name), slog.String("error", stopErr.Error()))
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		slog.Info("shutdown.complete", slog.String("service", a.name))
+	case <-shutdownCtx.Done():
+		slog.Warn("shutdown.timeout.reached", slog.String("service", a.name))
+	}
+	return err
+}
+
+// Fatal logs a failure to set the service up, stops the hooks added so far
+// and exits. Use it before Run, while components are being created.
+func (a *App) Fatal(event string, err error) {
+	slog.Error(event, slog.String("service", a.name), slog.String("error", err.Error()))
+
+	a.mu.Lock()
+	hooks := a.hooks
+	a.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if hooks[i].Start != nil || hooks[i].Run != nil {
+			// Only resources created before Run are held yet.
+			continue
+		}
+		if stopErr := stop(ctx, hooks[i]); stopErr != nil {
+			slog.Error("app.stop.failed", slog.String("service", a.name), slog.String("hook", hooks[i].Name), slog.String("error", stopErr.Error()))
+		}
+	}
+	os.Exit(1)
+}
+
+// stop calls the Stop of hook, giving up when ctx is done so that one stuck
+// component doesn't keep the others from stopping. Once the timeout has
+// passed, the remaining hooks are called directly: closing a resource is
+// quick, and a Stop that honours ctx returns at once.
+func stop(ctx context.Context, hook Hook) error {
+	if hook.Stop == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return hook.Stop(ctx)
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- hook.Stop(ctx)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("stop abandoned: %w", ctx.Err())
+	}
+}
+
+// New creates the lifecycle of the named service. Hooks get shutdownTimeout
+// to stop, all together.
+func New(name string, shutdownTimeout time.Duration) *App {
+	return &App{
+		name:            name,
+		shutdownTimeout: shutdownTimeout,
+	}
+}
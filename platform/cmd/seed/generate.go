@@ -0,0 +1,224 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	accountDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	catalogDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	"github.com/segmentio/ksuid"
+)
+
+// Each kind of record is drawn from its own stream, so that asking for more
+// orders leaves the accounts and products of a seed unchanged.
+const (
+	accountStream = iota + 1
+	productStream
+	orderStream
+)
+
+type dataset struct {
+	accounts []*accountDomain.Account
+	products []*catalogDomain.Catalog
+	orders   []*order
+}
+
+// order refers to its account and products by index until they have ids.
+type order struct {
+	id        string
+	account   int
+	createdAt time.Time
+	status    string
+	lines     []line
+}
+
+type line struct {
+	product  int
+	quantity uint32
+}
+
+// resolve turns o into a domain order once its account and products have
+// the ids in accountIds and productIds.
+func (o *order) resolve(products []*catalogDomain.Catalog, accountIds, productIds []string) *orderDomain.Order {
+	resolved := &orderDomain.Order{
+		Id:        o.id,
+		CreatedAt: o.createdAt,
+		AccountId: accountIds[o.account],
+		Status:    o.status,
+		Catalogs:  make([]*orderDomain.OrderedCatalog, len(o.lines)),
+	}
+	for i, l := range o.lines {
+		p := products[l.product]
+		resolved.Catalogs[i] = &orderDomain.OrderedCatalog{
+			Id:          productIds[l.product],
+			Name:        p.Name,
+			Description: p.Description,
+			Price:       p.Price,
+			Quantity:    l.quantity,
+		}
+		resolved.TotalPrice += p.Price * float64(l.quantity)
+	}
+	resolved.TotalPrice = math.Round(resolved.TotalPrice*100) / 100
+	return resolved
+}
+
+type category struct {
+	name      string
+	nouns     []string
+	materials []string
+	// Prices are log-normal around median; sigma sets their spread.
+	median float64
+	sigma  float64
+}
+
+var (
+	firstNames = []string{
+		"Ada", "Alan", "Amara", "Arjun", "Beatriz", "Chen", "Dmitri", "Elena", "Farah", "Gustav",
+		"Hana", "Ibrahim", "Ines", "Jonas", "Kenji", "Leila", "Lucas", "Maya", "Nikolai", "Noor",
+		"Olivia", "Pedro", "Priya", "Rafael", "Sara", "Tomas", "Uma", "Viktor", "Yara", "Zoe",
+	}
+	lastNames = []string{
+		"Abbasi", "Andersen", "Bauer", "Costa", "Dubois", "Eriksson", "Fischer", "Garcia", "Haddad", "Ivanova",
+		"Jensen", "Kowalski", "Lindqvist", "Moreau", "Nakamura", "Novak", "Okafor", "Petrov", "Quinn", "Rahimi",
+		"Rossi", "Santos", "Schmidt", "Tanaka", "Urquhart", "Varga", "Wagner", "Xu", "Yilmaz", "Zhang",
+	}
+	adjectives = []string{
+		"Classic", "Compact", "Everyday", "Handcrafted", "Lightweight", "Modern", "Premium", "Rustic",
+		"Sleek", "Sturdy", "Vintage", "Weatherproof",
+	}
+	uses = []string{
+		"daily use", "small apartments", "gifting", "the office", "travel", "weekend projects", "outdoor living",
+	}
+	categories = []category{
+		{"Kitchen", []string{"Mug", "Kettle", "Cutting Board", "Chef's Knife", "Teapot", "Salad Bowl"}, []string{"ceramic", "stainless steel", "bamboo", "cast iron"}, 24, 0.6},
+		{"Home", []string{"Desk Lamp", "Throw Blanket", "Wall Clock", "Planter", "Mirror", "Cushion"}, []string{"linen", "oak", "brass", "wool"}, 45, 0.7},
+		{"Electronics", []string{"Headphones", "Speaker", "Keyboard", "Webcam", "Charger", "Smartwatch"}, []string{"aluminium", "recycled plastic", "matte black", "graphite"}, 120, 0.8},
+		{"Outdoor", []string{"Backpack", "Tent", "Water Bottle", "Camping Stove", "Rain Jacket", "Hammock"}, []string{"ripstop nylon", "titanium", "canvas", "waxed cotton"}, 70, 0.9},
+		{"Stationery", []string{"Notebook", "Fountain Pen", "Planner", "Pencil Case", "Desk Organizer"}, []string{"kraft paper", "leather", "walnut", "felt"}, 14, 0.5},
+	}
+	statuses = []struct {
+		status string
+		weight int
+	}{
+		{orderDomain.OrderStatusPaid, 70},
+		{orderDomain.OrderStatusPending, 15},
+		{orderDomain.OrderStatusCancelled, 10},
+		{orderDomain.OrderStatusRefunded, 5},
+	}
+)
+
+func stream(seed uint64, kind uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, kind))
+}
+
+func pick[T any](r *rand.Rand, items []T) T {
+	return items[r.IntN(len(items))]
+}
+
+// id derives a ksuid from t, r and salt, so that ids are as reproducible as
+// the rest of the data and still sort by time.
+func id(r *rand.Rand, salt []byte, t time.Time) string {
+	payload := make([]byte, 16)
+	for i := range payload {
+		payload[i] = byte(r.Uint32()) ^ salt[i]
+	}
+	k, err := ksuid.FromParts(t, payload)
+	if err != nil {
+		panic(err)
+	}
+	return k.String()
+}
+
+// generate builds the dataset of seed for tenantId. Orders are spread over
+// the days before until. Only the ids depend on the tenant: ids are unique
+// across tenants, so each tenant seeded gets its own.
+func generate(seed uint64, tenantId string, accounts, products, orders int, until time.Time, days int) *dataset {
+	d := &dataset{}
+	sum := sha256.Sum256([]byte(tenantId))
+	salt := sum[:]
+
+	r := stream(seed, accountStream)
+	for range accounts {
+		d.accounts = append(d.accounts, &accountDomain.Account{
+			Id:   id(r, salt, until),
+			Name: pick(r, firstNames) + " " + pick(r, lastNames),
+		})
+	}
+
+	r = stream(seed, productStream)
+	for range products {
+		c := pick(r, categories)
+		adjective, material, noun := pick(r, adjectives), pick(r, c.materials), pick(r, c.nouns)
+		price := c.median * math.Exp(c.sigma*r.NormFloat64())
+		d.products = append(d.products, &catalogDomain.Catalog{
+			Id:   id(r, salt, until),
+			Name: fmt.Sprintf("%s %s %s", adjective, titleCase(material), noun),
+			Description: fmt.Sprintf("A %s %s in %s from our %s range, made for %s.",
+				strings.ToLower(adjective), strings.ToLower(noun), material, strings.ToLower(c.name), pick(r, uses)),
+			// Shelf prices end in .99.
+			Price: max(math.Floor(price), 0) + 0.99,
+		})
+	}
+
+	if accounts == 0 || products == 0 {
+		return d
+	}
+	r = stream(seed, orderStream)
+	// A few accounts and products account for most orders.
+	accountRank := rand.NewZipf(r, 1.3, 2, uint64(accounts-1))
+	productRank := rand.NewZipf(r, 1.2, 4, uint64(products-1))
+	window := time.Duration(days) * 24 * time.Hour
+	for range orders {
+		o := &order{
+			account:   int(accountRank.Uint64()),
+			createdAt: until.Add(-time.Duration(r.Int64N(int64(window)))).Truncate(time.Second),
+			status:    weightedStatus(r),
+		}
+		o.id = id(r, salt, o.createdAt)
+
+		n := min(1+int(math.Floor(r.ExpFloat64()*1.2)), 5, products)
+		seen := make(map[int]bool, n)
+		for len(o.lines) < n {
+			p := int(productRank.Uint64())
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			quantity := uint32(1)
+			if r.IntN(10) < 3 {
+				quantity += uint32(r.IntN(4))
+			}
+			o.lines = append(o.lines, line{product: p, quantity: quantity})
+		}
+		d.orders = append(d.orders, o)
+	}
+	return d
+}
+
+func titleCase(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+func weightedStatus(r *rand.Rand) string {
+	total := 0
+	for _, s := range statuses {
+		total += s.weight
+	}
+	n := r.IntN(total)
+	for _, s := range statuses {
+		if n < s.weight {
+			return s.status
+		}
+		n -= s.weight
+	}
+	return statuses[0].status
+}
@@ -0,0 +1,223 @@
+// Command seed fills the development databases with fake accounts, products
+// and orders.
+//
+//	go run ./platform/cmd/seed -accounts 50 -products 200 -orders 1000 -seed 7
+//
+// The same seed, counts and -until always generate the same data: names,
+// descriptions, prices, which accounts order which products and how many.
+// Records are written for the tenant named by -tenant; ids differ between
+// tenants, so one seed can fill several of them.
+// Popular accounts and products get most orders, and prices follow a
+// log-normal spread around a typical price per category.
+//
+// With -mode grpc, the default, records go through the public APIs of the
+// account, catalog and order services at ACCOUNT_PORT, CATALOG_PORT and
+// ORDER_PORT, which assign their own ids and times, so only the content is
+// reproducible. With -mode repository they are written straight to the
+// databases configured by ACCOUNT_POSTGRES_*, ORDER_POSTGRES_* and
+// ELASTICSEARCH_*, ids, order times and statuses included. Either way, -batch
+// records are written at a time. Settings may also come from the YAML file
+// named by CONFIG_FILE, like the services'.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	accountRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	catalogRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/utils"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	orderRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/configx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/grpcx"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/mtls"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/postgres"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+)
+
+// catalogIndex is the Elasticsearch index of the catalog service.
+const catalogIndex = "catalogs"
+
+type grpcConfig struct {
+	AccountPort  string   `env:"ACCOUNT_PORT"`
+	CatalogPort  string   `env:"CATALOG_PORT"`
+	OrderPort    string   `env:"ORDER_PORT"`
+	CertFile     string   `env:"TLS_CERT_FILE"`
+	KeyFile      string   `env:"TLS_KEY_FILE"`
+	CAFile       string   `env:"TLS_CA_FILE"`
+	AllowedPeers []string `env:"TLS_ALLOWED_PEERS" envSeparator:","`
+}
+
+func (c *grpcConfig) Validate() error {
+	var errs []error
+	for name, value := range map[string]string{"ACCOUNT_PORT": c.AccountPort, "CATALOG_PORT": c.CatalogPort, "ORDER_PORT": c.OrderPort} {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type repositoryConfig struct {
+	Account       postgres.Config `envPrefix:"ACCOUNT_POSTGRES_"`
+	Order         postgres.Config `envPrefix:"ORDER_POSTGRES_"`
+	ElasticSearch struct {
+		Host     string        `env:"ELASTICSEARCH_HOST"`
+		Port     string        `env:"ELASTICSEARCH_PORT"`
+		Username string        `env:"ELASTICSEARCH_USERNAME"`
+		Password string        `env:"ELASTICSEARCH_PASSWORD"`
+		Timeout  time.Duration `env:"ELASTICSEARCH_TIMEOUT"`
+	}
+}
+
+func main() {
+	seedValue := flag.Uint64("seed", 1, "random seed; the same seed generates the same data")
+	accounts := flag.Int("accounts", 50, "number of accounts")
+	products := flag.Int("products", 200, "number of products")
+	orders := flag.Int("orders", 500, "number of orders")
+	tenantId := flag.String("tenant", tenant.Default, "tenant the records belong to")
+	mode := flag.String("mode", "grpc", "where to write: grpc or repository")
+	batch := flag.Int("batch", 50, "records written at a time")
+	days := flag.Int("days", 90, "days before -until that orders are spread over")
+	until := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	flag.TextVar(&until, "until", until, "time of the newest possible order, RFC 3339")
+	flag.Parse()
+
+	if *accounts < 0 || *products < 0 || *orders < 0 || *batch < 1 || *days < 1 {
+		log.Fatal("counts must not be negative, and -batch and -days must be positive")
+	}
+	if *orders > 0 && (*accounts == 0 || *products == 0) {
+		log.Fatal("orders need at least one account and one product")
+	}
+	if !tenant.Valid(*tenantId) {
+		log.Fatalf("invalid tenant %q", *tenantId)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx = tenant.NewContext(ctx, *tenantId)
+
+	d := generate(*seedValue, *tenantId, *accounts, *products, *orders, until, *days)
+	if err := run(ctx, *mode, d, *batch); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, mode string, d *dataset, batch int) error {
+	var (
+		w       writer
+		cleanup func()
+		err     error
+	)
+	switch mode {
+	case "grpc":
+		w, cleanup, err = newGRPCWriter()
+	case "repository":
+		w, cleanup, err = newRepositoryWriter(ctx)
+	default:
+		err = fmt.Errorf("unknown mode %q", mode)
+	}
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	start := time.Now()
+	if err := seed(ctx, w, d, batch); err != nil {
+		return err
+	}
+	log.Printf("seeded %d accounts, %d products and %d orders in %s", len(d.accounts), len(d.products), len(d.orders), time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+func newGRPCWriter() (writer, func(), error) {
+	var cfg grpcConfig
+	if err := configx.Load(&cfg); err != nil {
+		return nil, nil, err
+	}
+	creds, err := mtls.NewCredentials(mtls.Config{
+		CertFile:     cfg.CertFile,
+		KeyFile:      cfg.KeyFile,
+		CAFile:       cfg.CAFile,
+		AllowedPeers: cfg.AllowedPeers,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retry := grpcx.RetryPolicy{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	breaker := func(name string) *grpcx.Breaker {
+		return grpcx.NewBreaker(name, 5, 10*time.Second)
+	}
+	accounts, err := accountHandler.NewGRPCAccountClient(cfg.AccountPort, creds, retry, breaker("account"))
+	if err != nil {
+		return nil, nil, err
+	}
+	catalogs, err := catalogHandler.NewGRPCCatalogClient(cfg.CatalogPort, creds, retry, breaker("catalog"))
+	if err != nil {
+		accounts.Close()
+		return nil, nil, err
+	}
+	orders, err := orderHandler.NewGRPCOrderClient(cfg.OrderPort, creds, retry, breaker("order"))
+	if err != nil {
+		accounts.Close()
+		catalogs.Close()
+		return nil, nil, err
+	}
+
+	w := &grpcWriter{accounts: accounts, catalogs: catalogs, orders: orders}
+	return w, func() {
+		accounts.Close()
+		catalogs.Close()
+		orders.Close()
+	}, nil
+}
+
+func newRepositoryWriter(ctx context.Context) (writer, func(), error) {
+	var cfg repositoryConfig
+	if err := configx.Load(&cfg); err != nil {
+		return nil, nil, err
+	}
+
+	accountDB, err := postgres.Connect(ctx, cfg.Account)
+	if err != nil {
+		return nil, nil, fmt.Errorf("account database: %w", err)
+	}
+	orderDB, err := postgres.Connect(ctx, cfg.Order)
+	if err != nil {
+		accountDB.Close()
+		return nil, nil, fmt.Errorf("order database: %w", err)
+	}
+	client, err := utils.NewElasticSearch(
+		utils.WithHost(cfg.ElasticSearch.Host),
+		utils.WithPort(cfg.ElasticSearch.Port),
+		utils.WithUsername(cfg.ElasticSearch.Username),
+		utils.WithPassword(cfg.ElasticSearch.Password),
+		utils.WithTimeout(cfg.ElasticSearch.Timeout),
+	).Connect()
+	if err != nil {
+		accountDB.Close()
+		orderDB.Close()
+		return nil, nil, fmt.Errorf("elasticsearch: %w", err)
+	}
+
+	w := &repositoryWriter{
+		accounts: accountRepository.NewAccountRepository(accountDB, accountDB),
+		catalogs: catalogRepository.NewCatalogRepository(client, catalogIndex),
+		orders:   orderRepository.NewOrderRepository(orderDB, orderDB),
+	}
+	return w, func() {
+		accountDB.Close()
+		orderDB.Close()
+	}, nil
+}
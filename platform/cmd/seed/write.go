@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	accountDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/account/domain"
+	accountDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/account/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/account/gateway/accountHandler"
+	accountRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/account/repository"
+	catalogDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/domain"
+	catalogDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/gateway/catalogHandler"
+	catalogRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/catalog/repository"
+	orderDomain "github.com/saleh-ghazimoradi/MircoEcoMarket/order/domain"
+	orderDTO "github.com/saleh-ghazimoradi/MircoEcoMarket/order/dto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/order/gateway/orderHandler"
+	orderRepository "github.com/saleh-ghazimoradi/MircoEcoMarket/order/repository"
+)
+
+// writer stores generated records and returns the ids they were stored
+// under.
+type writer interface {
+	account(ctx context.Context, account *accountDomain.Account) (string, error)
+	product(ctx context.Context, product *catalogDomain.Catalog) (string, error)
+	order(ctx context.Context, order *orderDomain.Order) error
+}
+
+// grpcWriter goes through the public APIs of the services, which validate
+// what they get and assign their own ids, times and statuses.
+type grpcWriter struct {
+	accounts accountHandler.GRPCAccountClient
+	catalogs catalogHandler.GRPCCatalogClient
+	orders   orderHandler.GRPCOrderClient
+}
+
+func (w *grpcWriter) account(ctx context.Context, account *accountDomain.Account) (string, error) {
+	created, err := w.accounts.CreateAccount(ctx, &accountDTO.Account{Name: account.Name})
+	if err != nil {
+		return "", err
+	}
+	return created.Id, nil
+}
+
+func (w *grpcWriter) product(ctx context.Context, product *catalogDomain.Catalog) (string, error) {
+	created, err := w.catalogs.CreateCatalog(ctx, &catalogDTO.Catalog{
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+	})
+	if err != nil {
+		return "", err
+	}
+	return created.Id, nil
+}
+
+func (w *grpcWriter) order(ctx context.Context, order *orderDomain.Order) error {
+	input := &orderDTO.Order{AccountId: order.AccountId}
+	for _, c := range order.Catalogs {
+		input.Catalogs = append(input.Catalogs, &orderDTO.OrderedCatalog{Id: c.Id, Quantity: c.Quantity})
+	}
+	_, err := w.orders.CreateOrder(ctx, input)
+	return err
+}
+
+// repositoryWriter stores records as generated, ids, order times and
+// statuses included, straight into the databases of the services.
+type repositoryWriter struct {
+	accounts accountRepository.AccountRepository
+	catalogs catalogRepository.CatalogRepository
+	orders   orderRepository.OrderRepository
+}
+
+func (w *repositoryWriter) account(ctx context.Context, account *accountDomain.Account) (string, error) {
+	return account.Id, w.accounts.CreateAccount(ctx, account)
+}
+
+func (w *repositoryWriter) product(ctx context.Context, product *catalogDomain.Catalog) (string, error) {
+	return product.Id, w.catalogs.CreateCatalog(ctx, product)
+}
+
+func (w *repositoryWriter) order(ctx context.Context, order *orderDomain.Order) error {
+	return w.orders.CreateOrder(ctx, order)
+}
+
+// seed writes d through w, batch records at a time.
+func seed(ctx context.Context, w writer, d *dataset, batch int) error {
+	accountIds := make([]string, len(d.accounts))
+	err := inBatches(ctx, "accounts", len(d.accounts), batch, func(ctx context.Context, i int) (err error) {
+		accountIds[i], err = w.account(ctx, d.accounts[i])
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	productIds := make([]string, len(d.products))
+	err = inBatches(ctx, "products", len(d.products), batch, func(ctx context.Context, i int) (err error) {
+		productIds[i], err = w.product(ctx, d.products[i])
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return inBatches(ctx, "orders", len(d.orders), batch, func(ctx context.Context, i int) error {
+		return w.order(ctx, d.orders[i].resolve(d.products, accountIds, productIds))
+	})
+}
+
+// inBatches calls write for 0 to n-1, size calls at a time, and stops after
+// the first batch with a failure.
+func inBatches(ctx context.Context, kind string, n, size int, write func(ctx context.Context, i int) error) error {
+	for start := 0; start < n; start += size {
+		end := min(start+size, n)
+		errs := make([]error, end-start)
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Go(func() {
+				errs[i-start] = write(ctx, i)
+			})
+		}
+		wg.Wait()
+		if err := errors.Join(errs...); err != nil {
+			return fmt.Errorf("%s %d-%d: %w", kind, start, end-1, err)
+		}
+		log.Printf("wrote %d/%d %s", end, n, kind)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Package configx loads the typed configuration of a service from the
+// environment.
+//
+// Values come, from highest to lowest precedence, from environment
+// variables, from the YAML file named by CONFIG_FILE and from envDefault
+// tags. The file may set variables by name or nest them:
+//
+//	ACCOUNT_PORT: ":50051"
+//	account_postgres:
+//	  host: localhost
+//	  max_open_conns: 25
+//
+// Any variable can also be read from a file by setting <NAME>_FILE to its
+// path, which is how container secrets are usually mounted.
+package configx
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv names the environment variable holding the path of the YAML file.
+const FileEnv = "CONFIG_FILE"
+
+// Validator is implemented by configuration structs that check their values
+// once loaded. Load calls it on the target and on every nested struct.
+type Validator interface {
+	Validate() error
+}
+
+// Load fills target, a pointer to a struct with env tags, and validates it.
+func Load(target any) error {
+	environment, err := readFile(os.Getenv(FileEnv))
+	if err != nil {
+		return err
+	}
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		environment[key] = value
+	}
+
+	opts := env.Options{Environment: environment}
+	params, err := env.GetFieldParamsWithOptions(target, opts)
+	if err != nil {
+		return err
+	}
+	for _, param := range params {
+		if err := readSecret(environment, param.Key); err != nil {
+			return err
+		}
+	}
+
+	if err := env.ParseWithOptions(target, opts); err != nil {
+		return err
+	}
+	return validate(reflect.ValueOf(target))
+}
+
+// readFile returns the variables set by the YAML file at path, if any.
+func readFile(path string) (map[string]string, error) {
+	environment := make(map[string]string)
+	if path == "" {
+		return environment, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config file: %w", err)
+	}
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	flatten(environment, "", doc)
+	return environment, nil
+}
+
+// flatten turns nested mappings into variable names joined by underscores;
+// sequences become the comma separated lists env tags expect.
+func flatten(environment map[string]string, prefix string, doc map[string]any) {
+	for key, value := range doc {
+		name := strings.ToUpper(prefix + key)
+		switch v := value.(type) {
+		case map[string]any:
+			flatten(environment, name+"_", v)
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			environment[name] = strings.Join(items, ",")
+		case nil:
+			environment[name] = ""
+		default:
+			environment[name] = fmt.Sprint(v)
+		}
+	}
+}
+
+// readSecret sets key from the file named by key_FILE. Setting both is
+// ambiguous and rejected.
+func readSecret(environment map[string]string, key string) error {
+	path, ok := environment[key+"_FILE"]
+	if !ok || path == "" {
+		return nil
+	}
+	if _, ok := environment[key]; ok {
+		return fmt.Errorf("config: both %s and %s_FILE are set", key, key)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: %s_FILE: %w", key, err)
+	}
+	environment[key] = strings.TrimRight(string(data), "\r\n")
+	return nil
+}
+
+func validate(v reflect.Value) error {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs []error
+	if v.CanAddr() {
+		if validator, ok := v.Addr().Interface().(Validator); ok {
+			errs = append(errs, validator.Validate())
+		}
+	} else if validator, ok := v.Interface().(Validator); ok {
+		errs = append(errs, validator.Validate())
+	}
+	for i := range v.NumField() {
+		if v.Type().Field(i).IsExported() {
+			errs = append(errs, validate(v.Field(i)))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,104 @@
+package grpcx
+
+import (
+	"context"
+	"time"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/requestid"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+// DialOptions returns the options every client connection is created with:
+// the payload limits of the servers, keepalive pings, request id and tenant
+// propagation and logging of failed calls. Interceptors given before these
+// options run first.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(MaxMsgSize),
+			grpc.MaxCallSendMsgSize(MaxMsgSize),
+		),
+		grpc.WithChainUnaryInterceptor(unaryClientRequestID, unaryClientTenant, unaryClientLogging),
+		grpc.WithChainStreamInterceptor(streamClientRequestID, streamClientTenant, streamClientLogging),
+	}
+}
+
+// clientRequestID forwards the request id of ctx, if any, to the server.
+func clientRequestID(ctx context.Context) context.Context {
+	id := requestid.FromContext(ctx)
+	if id == "" {
+		return ctx
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(requestIDKey)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, requestIDKey, id)
+}
+
+func unaryClientRequestID(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(clientRequestID(ctx), method, req, reply, cc, opts...)
+}
+
+func streamClientRequestID(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(clientRequestID(ctx), desc, cc, method, opts...)
+}
+
+// clientTenant forwards the tenant of ctx, if any, to the server. Unlike a
+// request id, a tenant already in the outgoing metadata is replaced or
+// dropped, as the gateway may have copied it from a caller's headers: only
+// the context decides whose data a call touches.
+func clientTenant(ctx context.Context) context.Context {
+	id := tenant.FromContext(ctx)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(tenantKey)) == 0 {
+		if id == "" {
+			return ctx
+		}
+		return metadata.AppendToOutgoingContext(ctx, tenantKey, id)
+	}
+	md = md.Copy()
+	if id == "" {
+		md.Delete(tenantKey)
+	} else {
+		md.Set(tenantKey, id)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func unaryClientTenant(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(clientTenant(ctx), method, req, reply, cc, opts...)
+}
+
+func streamClientTenant(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(clientTenant(ctx), desc, cc, method, opts...)
+}
+
+// unaryClientLogging logs failed calls; the server logs every call it
+// handles already.
+func unaryClientLogging(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		logCall(ctx, "grpc.client.failed", method, start, err)
+	}
+	return err
+}
+
+// streamClientLogging logs streams that fail to open. Errors received later
+// on the stream are left to the caller.
+func streamClientLogging(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		logCall(ctx, "grpc.client.failed", method, start, err)
+	}
+	return stream, err
+}
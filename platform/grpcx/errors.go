@@ -0,0 +1,92 @@
+package grpcx
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorRule maps a service error, and anything wrapping it, to a status
+// code and a stable reason clients can branch on. Field names the request
+// field at fault for InvalidArgument errors, when there is a single one.
+type ErrorRule struct {
+	Err    error
+	Code   codes.Code
+	Reason string
+	Field  string
+}
+
+// ErrorMapper turns service errors into gRPC statuses carrying errdetails:
+// every mapped status has an ErrorInfo, invalid arguments a BadRequest and
+// failed preconditions a PreconditionFailure.
+type ErrorMapper struct {
+	domain string
+	rules  []ErrorRule
+}
+
+// Status converts err. Errors that already carry a status, such as those of
+// a downstream service, keep it; unmapped errors become codes.Internal.
+func (m *ErrorMapper) Status(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	for _, rule := range m.rules {
+		if !errors.Is(err, rule.Err) {
+			continue
+		}
+		st := status.New(rule.Code, err.Error())
+		details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: rule.Reason, Domain: m.domain}}
+		switch rule.Code {
+		case codes.InvalidArgument:
+			details = append(details, &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: rule.Field, Description: rule.Err.Error()}},
+			})
+		case codes.FailedPrecondition:
+			details = append(details, &errdetails.PreconditionFailure{
+				Violations: []*errdetails.PreconditionFailure_Violation{{Type: rule.Reason, Description: rule.Err.Error()}},
+			})
+		}
+		if withDetails, detailsErr := st.WithDetails(details...); detailsErr == nil {
+			st = withDetails
+		}
+		return st.Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (m *ErrorMapper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, m.Status(err)
+	}
+}
+
+func (m *ErrorMapper) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return m.Status(handler(srv, ss))
+	}
+}
+
+// NewErrorMapper creates a mapper for one service; domain identifies it in
+// ErrorInfo details. Rules are tried in order.
+func NewErrorMapper(domain string, rules ...ErrorRule) *ErrorMapper {
+	return &ErrorMapper{
+		domain: domain,
+		rules:  rules,
+	}
+}
@@ -0,0 +1,259 @@
+package grpcx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultCallTimeout bounds unary calls made without a deadline, such as
+	// those of background jobs.
+	DefaultCallTimeout = 10 * time.Second
+	// maxBudgetReserve caps the share of the incoming deadline held back so
+	// that the caller has time to handle a failed call.
+	maxBudgetReserve = 250 * time.Millisecond
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+var (
+	breakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_client_circuit_breaker_state",
+		Help: "Circuit breaker state per target: 0 closed, 1 half-open, 2 open.",
+	}, []string{"target"})
+	breakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_circuit_breaker_rejections_total",
+		Help: "Calls failed fast by an open circuit breaker, per target.",
+	}, []string{"target"})
+)
+
+// RetryPolicy retries idempotent calls that fail with codes.Unavailable, with
+// exponential backoff. gRPC caps MaxAttempts at 5; below 2 nothing is
+// retried. grpc-go does not implement hedging, so retries are sequential.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// ResilienceOptions returns the dial options of a connection to service:
+// retries of the given read methods, a deadline budget for every unary call
+// and breaker.
+func ResilienceOptions(service string, reads []string, retry RetryPolicy, breaker *Breaker) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(retry.serviceConfig(service, reads)),
+		grpc.WithChainUnaryInterceptor(unaryClientBudget, breaker.unaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(breaker.streamClientInterceptor),
+	}
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicyConfig struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName       `json:"name"`
+	RetryPolicy *retryPolicyConfig `json:"retryPolicy,omitempty"`
+}
+
+type serviceConfig struct {
+	MethodConfig []methodConfig `json:"methodConfig"`
+}
+
+func (r RetryPolicy) serviceConfig(service string, reads []string) string {
+	config := serviceConfig{MethodConfig: []methodConfig{}}
+	if r.MaxAttempts >= 2 && len(reads) > 0 {
+		names := make([]methodName, 0, len(reads))
+		for _, method := range reads {
+			names = append(names, methodName{Service: service, Method: method})
+		}
+		config.MethodConfig = append(config.MethodConfig, methodConfig{
+			Name: names,
+			RetryPolicy: &retryPolicyConfig{
+				MaxAttempts:          r.MaxAttempts,
+				InitialBackoff:       seconds(r.InitialBackoff),
+				MaxBackoff:           seconds(r.MaxBackoff),
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		})
+	}
+	data, _ := json.Marshal(config)
+	return string(data)
+}
+
+// seconds formats d the way service configs spell durations.
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3fs", d.Seconds())
+}
+
+// unaryClientBudget derives the deadline of an outbound call from the one of
+// the request being served, keeping a reserve of a tenth of what is left. A
+// call that can't finish in time fails right away.
+func unaryClientBudget(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var cancel context.CancelFunc
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		ctx, cancel = context.WithTimeout(ctx, DefaultCallTimeout)
+	} else {
+		remaining := time.Until(deadline)
+		budget := remaining - min(remaining/10, maxBudgetReserve)
+		if budget <= 0 {
+			return status.Errorf(codes.DeadlineExceeded, "no time left to call %s", method)
+		}
+		ctx, cancel = context.WithTimeout(ctx, budget)
+	}
+	defer cancel()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerHalfOpen
+	BreakerOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker fails calls to one target fast once it keeps failing. After a
+// number of consecutive failed calls it opens; after a cooldown it lets a
+// single probe through, which closes it on success and reopens it on failure. Only
+// codes that point at the target count as failures.
+type Breaker struct {
+	target   string
+	failures int
+	cooldown time.Duration
+
+	mu          sync.Mutex
+	state       BreakerState
+	consecutive int
+	openedAt    time.Time
+	probing     bool
+}
+
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Check reports an error while the breaker is open, so that the breaker can
+// serve as a health check.
+func (b *Breaker) Check(context.Context) error {
+	if b.State() == BreakerOpen {
+		return fmt.Errorf("%w for %s", ErrCircuitOpen, b.target)
+	}
+	return nil
+}
+
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			break
+		}
+		b.setState(BreakerHalfOpen)
+		b.probing = true
+		return nil
+	case BreakerHalfOpen:
+		if b.probing {
+			break
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+	breakerRejections.WithLabelValues(b.target).Inc()
+	return status.Errorf(codes.Unavailable, "%s for %s", ErrCircuitOpen, b.target)
+}
+
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	switch status.Code(err) {
+	case codes.Canceled:
+		// The caller gave up; that says nothing about the target.
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		b.consecutive++
+		if b.state == BreakerHalfOpen || b.consecutive >= b.failures {
+			b.openedAt = time.Now()
+			b.setState(BreakerOpen)
+		}
+	default:
+		b.consecutive = 0
+		b.setState(BreakerClosed)
+	}
+}
+
+func (b *Breaker) setState(state BreakerState) {
+	b.state = state
+	breakerState.WithLabelValues(b.target).Set(float64(state))
+}
+
+func (b *Breaker) unaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	b.record(err)
+	return err
+}
+
+// streamClientInterceptor guards opening streams. Errors later on the stream
+// are not recorded: long-lived streams end for many reasons.
+func (b *Breaker) streamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	b.record(err)
+	return stream, err
+}
+
+// NewBreaker creates the breaker of one target. Share it between all
+// connections to that target.
+func NewBreaker(target string, failures int, cooldown time.Duration) *Breaker {
+	b := &Breaker{
+		target:   target,
+		failures: max(failures, 1),
+		cooldown: cooldown,
+	}
+	b.setState(BreakerClosed)
+	return b
+}
@@ -0,0 +1,235 @@
+package grpcx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/requestid"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultTimeout bounds unary RPCs whose caller set no deadline. Streams
+	// such as order watches are long-lived by design and are left alone.
+	DefaultTimeout = 30 * time.Second
+	// MaxMsgSize caps request and response payloads in both directions.
+	MaxMsgSize = 4 << 20
+
+	// Clients ping idle connections so that dead peers behind load balancers
+	// are noticed; servers accept pings up to twice as often.
+	keepaliveTime    = 30 * time.Second
+	keepaliveTimeout = 10 * time.Second
+
+	// requestIDKey carries the request id in metadata, and tenantKey the
+	// tenant; keys are lower case.
+	requestIDKey            = "x-request-id"
+	tenantKey               = "x-tenant-id"
+	healthServicePrefix     = "/grpc.health.v1.Health/"
+	reflectionServicePrefix = "/grpc.reflection."
+)
+
+// ServerOptions returns the options every service creates its server with:
+// payload limits, the keepalive policy and, from outermost to innermost,
+// request ids and tenants, logging, panic recovery, the tenant requirement,
+// default deadlines and mapper. Interceptors chained before these options
+// run first.
+func ServerOptions(mapper *ErrorMapper) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(MaxMsgSize),
+		grpc.MaxSendMsgSize(MaxMsgSize),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveTime / 2,
+			PermitWithoutStream: true,
+		}),
+		grpc.ChainUnaryInterceptor(
+			unaryServerRequestID,
+			unaryServerTenant,
+			unaryServerLogging,
+			unaryServerRecovery,
+			unaryServerRequireTenant,
+			unaryServerDeadline,
+			mapper.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			streamServerRequestID,
+			streamServerTenant,
+			streamServerLogging,
+			streamServerRecovery,
+			streamServerRequireTenant,
+			mapper.StreamServerInterceptor(),
+		),
+	}
+}
+
+// serverRequestID takes the request id from the incoming metadata, or makes
+// one up, stores it in the context and echoes it in the trailer. A header
+// would make failed calls look committed to the client, which then won't
+// retry them.
+func serverRequestID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDKey); len(values) > 0 && len(values[0]) <= requestid.MaxLength {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = requestid.New()
+	}
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(requestIDKey, id))
+	return requestid.NewContext(ctx, id)
+}
+
+func unaryServerRequestID(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(serverRequestID(ctx), req)
+}
+
+func streamServerRequestID(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: serverRequestID(ss.Context())})
+}
+
+// serverTenant stores the tenant of the incoming metadata in the context.
+// Malformed tenants are dropped, which leaves the call without one.
+func serverTenant(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tenantKey); len(values) > 0 && tenant.Valid(values[0]) {
+			return tenant.NewContext(ctx, values[0])
+		}
+	}
+	return ctx
+}
+
+func unaryServerTenant(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(serverTenant(ctx), req)
+}
+
+func streamServerTenant(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: serverTenant(ss.Context())})
+}
+
+// errNoTenant rejects calls that don't say whose data they are about.
+var errNoTenant = status.Error(codes.Unauthenticated, "tenant required")
+
+// needsTenant reports whether method works on tenant data. Health checks and
+// reflection don't.
+func needsTenant(method string) bool {
+	return !strings.HasPrefix(method, healthServicePrefix) && !strings.HasPrefix(method, reflectionServicePrefix)
+}
+
+func unaryServerRequireTenant(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if needsTenant(info.FullMethod) && tenant.FromContext(ctx) == "" {
+		return nil, errNoTenant
+	}
+	return handler(ctx, req)
+}
+
+func streamServerRequireTenant(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if needsTenant(info.FullMethod) && tenant.FromContext(ss.Context()) == "" {
+		return errNoTenant
+	}
+	return handler(srv, ss)
+}
+
+func unaryServerLogging(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, "grpc.server.handled", info.FullMethod, start, err)
+	return resp, err
+}
+
+func streamServerLogging(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), "grpc.server.handled", info.FullMethod, start, err)
+	return err
+}
+
+// unaryServerRecovery turns a panicking handler into codes.Internal. The
+// panic value and stack go to the log, never to the caller.
+func unaryServerRecovery(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func streamServerRecovery(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func recovered(ctx context.Context, method string, r any) error {
+	slog.ErrorContext(ctx, "grpc.server.panic",
+		slog.String("method", method),
+		slog.String("request_id", requestid.FromContext(ctx)),
+		slog.String("panic", fmt.Sprint(r)),
+		slog.String("stack", string(debug.Stack())),
+	)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func unaryServerDeadline(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultTimeout)
+		defer cancel()
+	}
+	return handler(ctx, req)
+}
+
+// logCall logs a finished call at a level that follows its status code.
+// Health checks are polled constantly and only logged when they fail.
+func logCall(ctx context.Context, event, method string, start time.Time, err error) {
+	code := status.Code(err)
+	if code == codes.OK && strings.HasPrefix(method, healthServicePrefix) {
+		return
+	}
+
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK, codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.FailedPrecondition, codes.OutOfRange, codes.Unauthenticated:
+	case codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		level = slog.LevelWarn
+	default:
+		level = slog.LevelError
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+		slog.String("request_id", requestid.FromContext(ctx)),
+	}
+	if id := tenant.FromContext(ctx); id != "" {
+		attrs = append(attrs, slog.String("tenant", id))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	slog.LogAttrs(ctx, level, event, attrs...)
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
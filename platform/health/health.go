@@ -0,0 +1,169 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	checkInterval = 10 * time.Second
+	checkTimeout  = 3 * time.Second
+)
+
+// Check reports whether a dependency is usable. (*sql.DB).PingContext is one.
+type Check func(ctx context.Context) error
+
+// Checker serves grpc.health.v1 for one service. Its status follows the
+// dependency checks, which run in the background: the service is SERVING
+// only while every check passes.
+//
+// Downstream checks cover the other services this one calls. They don't
+// affect its status, which would take every caller out of rotation with
+// them; each is reported as a service of its own, named after the check.
+type Checker struct {
+	service    string
+	checks     map[string]Check
+	downstream map[string]Check
+	server     *health.Server
+
+	mu      sync.Mutex
+	failing map[string]string
+}
+
+// Register adds the health service to server. Call it before server.Serve.
+func (c *Checker) Register(server *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(server, c.server)
+}
+
+// Run checks the dependencies right away and then periodically until ctx
+// is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		c.checkOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING from now on, so that load balancers drain the
+// instance while it stops.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+func (c *Checker) checkOnce(ctx context.Context) {
+	failing := run(ctx, c.checks)
+	failingDownstream := run(ctx, c.downstream)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	all := maps.Clone(failing)
+	maps.Copy(all, failingDownstream)
+	for name, reason := range all {
+		if _, ok := c.failing[name]; !ok {
+			slog.Warn("health.check.failed", slog.String("check", name), slog.String("error", reason))
+		}
+	}
+	for name := range c.failing {
+		if _, ok := all[name]; !ok {
+			slog.Info("health.check.recovered", slog.String("check", name))
+		}
+	}
+	c.failing = all
+
+	c.server.SetServingStatus("", servingStatus(len(failing) == 0))
+	c.server.SetServingStatus(c.service, servingStatus(len(failing) == 0))
+	for name := range c.downstream {
+		_, down := failingDownstream[name]
+		c.server.SetServingStatus(name, servingStatus(!down))
+	}
+}
+
+// run runs checks one after another and returns why the failing ones failed.
+func run(ctx context.Context, checks map[string]Check) map[string]string {
+	failing := make(map[string]string)
+	for _, name := range slices.Sorted(maps.Keys(checks)) {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := checks[name](checkCtx)
+		cancel()
+		if err != nil {
+			failing[name] = err.Error()
+		}
+	}
+	return failing
+}
+
+func servingStatus(ok bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if ok {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// NewChecker creates a checker for the named gRPC service, e.g.
+// "catalog.CatalogService", with checks of its own dependencies and of the
+// services downstream of it. Everything reports NOT_SERVING until the first
+// round of checks has passed.
+func NewChecker(service string, checks, downstream map[string]Check) *Checker {
+	server := health.NewServer()
+	for _, name := range append([]string{"", service}, slices.Collect(maps.Keys(downstream))...) {
+		server.SetServingStatus(name, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+	return &Checker{
+		service:    service,
+		checks:     checks,
+		downstream: downstream,
+		server:     server,
+	}
+}
+
+// ErrNotServing is returned by Remote checks of services that are up but
+// report themselves unhealthy.
+var ErrNotServing = errors.New("service is not serving")
+
+// Remote checks a downstream service through its grpc.health.v1 service.
+type Remote struct {
+	conn   *grpc.ClientConn
+	client grpc_health_v1.HealthClient
+}
+
+func (r *Remote) Check(ctx context.Context) error {
+	resp, err := r.client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: %s", ErrNotServing, resp.GetStatus())
+	}
+	return nil
+}
+
+func (r *Remote) Close() error {
+	return r.conn.Close()
+}
+
+func NewRemote(addr string, opts ...grpc.DialOption) (*Remote, error) {
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Remote{
+		conn:   conn,
+		client: grpc_health_v1.NewHealthClient(conn),
+	}, nil
+}
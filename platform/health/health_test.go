@@ -0,0 +1,31 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestDownstreamDoesntAffectStatus(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("unavailable") }
+	c := NewChecker("order.OrderService", map[string]Check{"postgres": ok}, map[string]Check{"account": down, "catalog": ok})
+	c.checkOnce(t.Context())
+
+	for service, want := range map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+		"":                   grpc_health_v1.HealthCheckResponse_SERVING,
+		"order.OrderService": grpc_health_v1.HealthCheckResponse_SERVING,
+		"account":            grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		"catalog":            grpc_health_v1.HealthCheckResponse_SERVING,
+	} {
+		resp, err := c.server.Check(t.Context(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetStatus() != want {
+			t.Errorf("status of %q = %s, want %s", service, resp.GetStatus(), want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+type report struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services,omitempty"`
+}
+
+// LivenessHandler answers 200 for as long as the process serves HTTP.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, report{Status: "ok"})
+	})
+}
+
+// ReadinessHandler runs the checks concurrently and answers 200 when all of
+// them pass and 503 otherwise, with the outcome of each check in the body.
+// The outcomes of details are reported too, but they don't decide readiness.
+func ReadinessHandler(checks, details map[string]Check) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+		defer cancel()
+
+		var (
+			mu sync.Mutex
+			wg sync.WaitGroup
+		)
+		rep := report{Status: "ok", Services: make(map[string]string, len(checks)+len(details))}
+		start := func(name string, check Check, decides bool) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result := "ok"
+				if err := check(ctx); err != nil {
+					result = err.Error()
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				rep.Services[name] = result
+				if decides && result != "ok" {
+					rep.Status = "unavailable"
+				}
+			}()
+		}
+		for name, check := range checks {
+			start(name, check, true)
+		}
+		for name, check := range details {
+			start(name, check, false)
+		}
+		wg.Wait()
+
+		code := http.StatusOK
+		if rep.Status != "ok" {
+			code = http.StatusServiceUnavailable
+		}
+		writeReport(w, code, rep)
+	})
+}
+
+func writeReport(w http.ResponseWriter, code int, rep report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(rep)
+}
@@ -0,0 +1,39 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadinessDetailsDontDecide(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	open := func(context.Context) error { return errors.New("circuit open") }
+	tests := []struct {
+		name     string
+		checks   map[string]Check
+		wantCode int
+	}{
+		{"checks pass", map[string]Check{"order": ok}, http.StatusOK},
+		{"check fails", map[string]Check{"order": open}, http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ReadinessHandler(tt.checks, map[string]Check{"cart_breaker": open}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if rec.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			var rep report
+			if err := json.NewDecoder(rec.Body).Decode(&rep); err != nil {
+				t.Fatal(err)
+			}
+			if rep.Services["cart_breaker"] != "circuit open" {
+				t.Errorf("cart_breaker = %q, want it reported", rep.Services["cart_breaker"])
+			}
+		})
+	}
+}
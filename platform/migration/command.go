@@ -0,0 +1,232 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const usage = `usage: %[1]s migrate [--dry-run] <command>
+
+commands:
+  up          apply every pending migration
+  down N      revert the last N migrations
+  goto V      migrate up or down to version V
+  force V     mark version V as applied and clean, running nothing
+  status      show the applied version and every migration
+  create NAME add empty up and down files for a new migration to --dir
+
+flags:
+`
+
+var (
+	ErrUsage = errors.New("migrate: invalid arguments")
+
+	fileName = regexp.MustCompile(`^(\d+)_.+\.(up|down)\.sql$`)
+	nonWord  = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Command runs the migrate subcommand of a service binary. args are the
+// arguments after "migrate"; dir is the source directory new migrations are
+// created in, and open connects to the database of the service.
+func Command(ctx context.Context, service string, args []string, dir string, open func(context.Context) (*Migrator, func() error, error), out io.Writer) error {
+	flags := flag.NewFlagSet(service+" migrate", flag.ContinueOnError)
+	flags.SetOutput(out)
+	dryRun := flags.Bool("dry-run", false, "print the SQL that would run instead of running it")
+	flags.StringVar(&dir, "dir", dir, "directory create writes to")
+	flags.Usage = func() {
+		fmt.Fprintf(out, usage, service)
+		flags.PrintDefaults()
+	}
+
+	// Flags may come before, between or after the positional arguments.
+	var positional []string
+	for {
+		if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+			return err
+		} else if err != nil {
+			return fmt.Errorf("%w: %v", ErrUsage, err)
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+	if len(positional) == 0 {
+		flags.Usage()
+		return ErrUsage
+	}
+
+	command, operands := positional[0], positional[1:]
+	if command == "create" {
+		if len(operands) != 1 {
+			flags.Usage()
+			return ErrUsage
+		}
+		return create(dir, operands[0], out)
+	}
+
+	var number int
+	switch command {
+	case "up", "status":
+		if len(operands) != 0 {
+			flags.Usage()
+			return ErrUsage
+		}
+	case "down", "goto", "force":
+		if len(operands) != 1 {
+			flags.Usage()
+			return ErrUsage
+		}
+		n, err := strconv.Atoi(operands[0])
+		if err != nil || n < 0 && command != "force" || n < NilVersion {
+			return fmt.Errorf("%w: %q is not a valid number", ErrUsage, operands[0])
+		}
+		number = n
+	default:
+		flags.Usage()
+		return fmt.Errorf("%w: unknown command %q", ErrUsage, command)
+	}
+
+	m, closeDB, err := open(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	defer m.Close()
+
+	if command == "status" {
+		return status(m, out)
+	}
+
+	if *dryRun {
+		var target int
+		switch command {
+		case "up":
+			target, err = m.Latest()
+		case "down":
+			target, err = m.Target(number)
+		case "goto":
+			target = number
+		case "force":
+			fmt.Fprintf(out, "-- would mark version %d as applied and clean\n", number)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return plan(m, target, out)
+	}
+
+	switch command {
+	case "up":
+		return m.Up(ctx)
+	case "down":
+		return m.Down(ctx, number)
+	case "goto":
+		return m.Goto(ctx, uint(number))
+	default:
+		return m.Force(ctx, number)
+	}
+}
+
+func status(m *Migrator, out io.Writer) error {
+	current, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	latest, err := m.Latest()
+	if err != nil {
+		return err
+	}
+	steps, err := m.Plan(NilVersion)
+	if err != nil {
+		return err
+	}
+	pending, err := m.Plan(latest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "version: %d, dirty: %t\n\n", current, dirty)
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE")
+	for i := len(steps) - 1; i >= 0; i-- {
+		state := "applied"
+		if dirty && int(steps[i].Version) == current {
+			state = "dirty"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", steps[i].Version, steps[i].Identifier, state)
+	}
+	for _, step := range pending {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", step.Version, step.Identifier, "pending")
+	}
+	return w.Flush()
+}
+
+func plan(m *Migrator, target int, out io.Writer) error {
+	steps, err := m.Plan(target)
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		fmt.Fprintln(out, "-- nothing to migrate")
+		return nil
+	}
+	for _, step := range steps {
+		direction := "down"
+		if step.Up {
+			direction = "up"
+		}
+		sql, err := m.SQL(step)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "-- %d_%s.%s.sql\n%s\n", step.Version, step.Identifier, direction, strings.TrimRight(sql, "\n"))
+	}
+	return nil
+}
+
+// create writes the up and down files of a migration numbered after the
+// newest one in dir.
+func create(dir, name string, out io.Writer) error {
+	name = strings.Trim(nonWord.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return fmt.Errorf("%w: empty migration name", ErrUsage)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	next := 1
+	for _, entry := range entries {
+		if match := fileName.FindStringSubmatch(entry.Name()); match != nil {
+			if v, _ := strconv.Atoi(match[1]); v >= next {
+				next = v + 1
+			}
+		}
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%04d_%s.%s.sql", next, name, direction))
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, path)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// Package migration applies the embedded SQL migrations of a service. Every
+// change to the schema runs under a Postgres advisory lock, so replicas
+// starting together, or an operator running the migrate command while they
+// do, apply each migration once.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// NilVersion is the version of a database without migrations.
+const NilVersion = -1
+
+type Migrator struct {
+	db        *sql.DB
+	dbName    string
+	source    source.Driver
+	migration *migrate.Migrate
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, "up", m.migration.Up)
+}
+
+// Down reverts the last n migrations.
+func (m *Migrator) Down(ctx context.Context, n int) error {
+	return m.locked(ctx, "down", func() error {
+		return m.migration.Steps(-n)
+	})
+}
+
+// Goto migrates up or down to version.
+func (m *Migrator) Goto(ctx context.Context, version uint) error {
+	return m.locked(ctx, "goto", func() error {
+		return m.migration.Migrate(version)
+	})
+}
+
+// Force records version as applied and clean without running anything. It
+// repairs a database left dirty by a failed migration, once fixed by hand.
+func (m *Migrator) Force(ctx context.Context, version int) error {
+	return m.locked(ctx, "force", func() error {
+		return m.migration.Force(version)
+	})
+}
+
+// Version returns the applied version, NilVersion if none, and whether the
+// last migration failed halfway.
+func (m *Migrator) Version() (int, bool, error) {
+	version, dirty, err := m.migration.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return NilVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return int(version), dirty, nil
+}
+
+// locked runs fn while holding the migration lock of the database. The lock
+// is taken on a connection of its own and waits as long as ctx allows.
+func (m *Migrator) locked(ctx context.Context, action string, fn func() error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	key := "migrations:" + m.dbName
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock(hashtext($1))`, key); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock(hashtext($1))`, key); err != nil {
+			slog.Error("migrations.unlock.failed", slog.String("db", m.dbName), slog.String("error", err.Error()))
+		}
+	}()
+
+	if err := fn(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to migrate %s: %w", action, err)
+	}
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	slog.Info("migrations.applied", slog.String("db", m.dbName), slog.String("action", action), slog.Int("version", version), slog.Bool("dirty", dirty))
+	return nil
+}
+
+// Step is one migration file.
+type Step struct {
+	Version    uint
+	Identifier string
+	Up         bool
+}
+
+// Plan lists the migrations that migrating from the applied version to
+// target would run, in order. A target of NilVersion reverts everything.
+func (m *Migrator) Plan(target int) ([]Step, error) {
+	current, _, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+	versions, err := m.versions()
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []Step
+	if target >= current {
+		for _, v := range versions {
+			if int(v) > current && int(v) <= target {
+				steps = append(steps, Step{Version: v, Up: true})
+			}
+		}
+	} else {
+		for i := len(versions) - 1; i >= 0; i-- {
+			if v := versions[i]; int(v) <= current && int(v) > target {
+				steps = append(steps, Step{Version: v})
+			}
+		}
+	}
+	for i := range steps {
+		r, identifier, err := m.read(steps[i])
+		if err != nil {
+			return nil, err
+		}
+		r.Close()
+		steps[i].Identifier = identifier
+	}
+	return steps, nil
+}
+
+// Target returns the version that Down(n) would leave the database at.
+func (m *Migrator) Target(n int) (int, error) {
+	current, _, err := m.Version()
+	if err != nil {
+		return 0, err
+	}
+	versions, err := m.versions()
+	if err != nil {
+		return 0, err
+	}
+	applied := 0
+	for _, v := range versions {
+		if int(v) <= current {
+			applied++
+		}
+	}
+	if n >= applied {
+		return NilVersion, nil
+	}
+	return int(versions[applied-n-1]), nil
+}
+
+// Latest returns the version of the newest migration.
+func (m *Migrator) Latest() (int, error) {
+	versions, err := m.versions()
+	if err != nil || len(versions) == 0 {
+		return NilVersion, err
+	}
+	return int(versions[len(versions)-1]), nil
+}
+
+// SQL returns the statements of step.
+func (m *Migrator) SQL(step Step) (string, error) {
+	r, _, err := m.read(step)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	return string(data), err
+}
+
+func (m *Migrator) read(step Step) (io.ReadCloser, string, error) {
+	if step.Up {
+		return m.source.ReadUp(step.Version)
+	}
+	return m.source.ReadDown(step.Version)
+}
+
+// versions lists the versions of the embedded migrations in order.
+func (m *Migrator) versions() ([]uint, error) {
+	v, err := m.source.First()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	versions := []uint{v}
+	for {
+		v, err = m.source.Next(v)
+		if errors.Is(err, os.ErrNotExist) {
+			return versions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+}
+
+// Close releases the migration driver. The database belongs to the caller.
+func (m *Migrator) Close() error {
+	source, driver := m.migration.Close()
+	if source != nil {
+		return fmt.Errorf("failed to close source: %w", source)
+	}
+	if driver != nil {
+		return fmt.Errorf("failed to close driver: %w", driver)
+	}
+	return nil
+}
+
+// New creates the migrator of the migrations in fsys, files named like
+// 0001_create_account_table.up.sql.
+func New(db *sql.DB, dbName string, fsys fs.FS) (*Migrator, error) {
+	driver, err := postgres.WithInstance(db, &postgres.Config{DatabaseName: dbName})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration driver: %w", err)
+	}
+
+	src, err := iofs.New(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migration files: %w", err)
+	}
+	// migrate closes the source it's given; plans read a source of their own.
+	planSrc, err := iofs.New(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migration files: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, dbName, driver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize migrate: %w", err)
+	}
+
+	return &Migrator{
+		db:        db,
+		dbName:    dbName,
+		source:    planSrc,
+		migration: m,
+	}, nil
+}
@@ -0,0 +1,260 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// reloadInterval is how often, at most, handshakes look for rotated files.
+const reloadInterval = 10 * time.Second
+
+var (
+	ErrIncompleteConfig = errors.New("tls: cert, key and CA files must be set together")
+	ErrNoCACerts        = errors.New("tls: CA file holds no certificates")
+)
+
+// Config names the PEM files of a service's identity and of the CA that
+// signs its peers. AllowedPeers lists the DNS or URI SANs whose certificates
+// may call the service's servers; when empty any certificate the CA signed
+// may. Without files, links stay plaintext.
+type Config struct {
+	CertFile     string
+	KeyFile      string
+	CAFile       string
+	AllowedPeers []string
+}
+
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+// Credentials secure both the servers and the clients of a service with one
+// certificate. Rotated files are picked up on the next handshake.
+type Credentials struct {
+	config Config
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTimes  [3]time.Time
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+}
+
+// ServerOptions returns the transport credentials of a server together with
+// interceptors that reject peers outside AllowedPeers. Pass them before any
+// other interceptors, so that those never run for rejected peers.
+func (c *Credentials) ServerOptions() []grpc.ServerOption {
+	if !c.config.Enabled() {
+		return []grpc.ServerOption{grpc.Creds(insecure.NewCredentials())}
+	}
+	return []grpc.ServerOption{
+		grpc.Creds(&transport{credentials: c}),
+		grpc.ChainUnaryInterceptor(c.unaryServerInterceptor),
+		grpc.ChainStreamInterceptor(c.streamServerInterceptor),
+	}
+}
+
+// DialOption returns the transport credentials of a client connection.
+func (c *Credentials) DialOption() grpc.DialOption {
+	return grpc.WithTransportCredentials(c.transportCredentials())
+}
+
+func (c *Credentials) transportCredentials() credentials.TransportCredentials {
+	if !c.config.Enabled() {
+		return insecure.NewCredentials()
+	}
+	return &transport{credentials: c}
+}
+
+// current returns the certificate and CA pool, reloading them when one of
+// the files changed since the last look.
+func (c *Credentials) current() (*tls.Certificate, *x509.CertPool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cert != nil && time.Since(c.checkedAt) < reloadInterval {
+		return c.cert, c.pool, nil
+	}
+	c.checkedAt = time.Now()
+
+	var modTimes [3]time.Time
+	for i, name := range []string{c.config.CertFile, c.config.KeyFile, c.config.CAFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return c.keep(err)
+		}
+		modTimes[i] = info.ModTime()
+	}
+	if c.cert != nil && modTimes == c.modTimes {
+		return c.cert, c.pool, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.config.CertFile, c.config.KeyFile)
+	if err != nil {
+		return c.keep(err)
+	}
+	caPEM, err := os.ReadFile(c.config.CAFile)
+	if err != nil {
+		return c.keep(err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return c.keep(ErrNoCACerts)
+	}
+	c.cert, c.pool, c.modTimes = &cert, pool, modTimes
+	return c.cert, c.pool, nil
+}
+
+// keep serves the last good files while a rotation is half written.
+func (c *Credentials) keep(err error) (*tls.Certificate, *x509.CertPool, error) {
+	if c.cert != nil {
+		return c.cert, c.pool, nil
+	}
+	return nil, nil, err
+}
+
+func (c *Credentials) serverConfig() (*tls.Config, error) {
+	cert, pool, err := c.current()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (c *Credentials) clientConfig(serverName string) (*tls.Config, error) {
+	cert, pool, err := c.current()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// authorize checks the verified client certificate of ctx against
+// AllowedPeers.
+func (c *Credentials) authorize(ctx context.Context) error {
+	if len(c.config.AllowedPeers) == 0 {
+		return nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no peer")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	leaf := info.State.VerifiedChains[0][0]
+	names := slices.Clone(leaf.DNSNames)
+	for _, uri := range leaf.URIs {
+		names = append(names, uri.String())
+	}
+	for _, name := range names {
+		if slices.Contains(c.config.AllowedPeers, name) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "peer %v is not allowed", names)
+}
+
+func (c *Credentials) unaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := c.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (c *Credentials) streamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := c.authorize(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// transport builds a fresh TLS configuration for every handshake, which is
+// what lets rotated certificates take effect without a restart.
+type transport struct {
+	credentials *Credentials
+	serverName  string
+}
+
+func (t *transport) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	serverName := t.serverName
+	if serverName == "" {
+		serverName = authority
+		if host, _, err := net.SplitHostPort(authority); err == nil {
+			serverName = host
+		}
+		// Targets such as ":50051" name no host; dev certificates cover
+		// localhost.
+		if serverName == "" {
+			serverName = "localhost"
+		}
+	}
+	config, err := t.credentials.clientConfig(serverName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(config).ClientHandshake(ctx, authority, conn)
+}
+
+func (t *transport) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	config, err := t.credentials.serverConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(config).ServerHandshake(conn)
+}
+
+func (t *transport) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls", SecurityVersion: "1.2"}
+}
+
+func (t *transport) Clone() credentials.TransportCredentials {
+	clone := *t
+	return &clone
+}
+
+func (t *transport) OverrideServerName(serverName string) error {
+	t.serverName = serverName
+	return nil
+}
+
+// NewCredentials loads the files of config once to fail fast on mistakes.
+// A config without files yields plaintext credentials.
+func NewCredentials(config Config) (*Credentials, error) {
+	c := &Credentials{config: config}
+	if !config.Enabled() {
+		return c, nil
+	}
+	if config.CertFile == "" || config.KeyFile == "" || config.CAFile == "" {
+		return nil, ErrIncompleteConfig
+	}
+	if _, _, err := c.current(); err != nil {
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+	return c, nil
+}
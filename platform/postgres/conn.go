@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+)
+
+// TenantSetting is the session setting that row-level security policies
+// compare tenant_id columns with. Every statement sent through a pool of
+// this package runs with it set to the tenant of the statement's context,
+// or to "" without one, which policies treat as no tenant at all.
+const TenantSetting = "app.tenant_id"
+
+// lsnQuery returns the position up to which the server has written WAL,
+// which is past every transaction committed before it runs.
+const lsnQuery = `SELECT pg_current_wal_lsn()::text`
+
+// bypassQuery tells whether the session's role skips row-level security.
+const bypassQuery = `SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`
+
+// ErrBypassesRLS is returned for connections whose role would see and
+// change the rows of every tenant.
+var ErrBypassesRLS = errors.New("postgres: role is a superuser or has BYPASSRLS, which skips the tenant policies")
+
+// pgConn is what lib/pq connections implement and conn passes on.
+type pgConn interface {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+	driver.QueryerContext
+	driver.ExecerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+// connector opens the connections of the pool of database db. Those of
+// other pools than the owner's are refused when their role bypasses
+// row-level security.
+type connector struct {
+	driver.Connector
+	db    string
+	owner bool
+}
+
+func (c connector) Connect(ctx context.Context) (driver.Conn, error) {
+	dc, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pc, ok := dc.(pgConn)
+	if !ok {
+		dc.Close()
+		return nil, fmt.Errorf("postgres: %T can't be scoped to tenants", dc)
+	}
+	if !c.owner {
+		if err := checkRole(ctx, pc); err != nil {
+			pc.Close()
+			return nil, err
+		}
+	}
+	// A new session has the setting unset, which reads as "".
+	return &conn{pgConn: pc, db: c.db, known: true}, nil
+}
+
+func checkRole(ctx context.Context, pc pgConn) error {
+	rows, err := pc.QueryContext(ctx, bypassQuery, nil)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		return fmt.Errorf("postgres: checking the role: %w", err)
+	}
+	if bypass, ok := dest[0].(bool); !ok || bypass {
+		return ErrBypassesRLS
+	}
+	return nil
+}
+
+// conn scopes statements to their tenant and records the writes it commits
+// in the session of their context; see WithSession.
+//
+// It sets TenantSetting before each statement whose tenant differs from the
+// one the session holds. database/sql never shares a connection, so the
+// statement runs right after, and rows it streams are read before the next
+// statement can change the setting.
+type conn struct {
+	pgConn
+	db string
+	// tenant is the value of the setting in the session, unless known is
+	// false: a rolled back transaction may have undone the last change.
+	tenant string
+	known  bool
+	// inTx is set while a transaction is open; its writes are recorded when
+	// it commits.
+	inTx bool
+}
+
+func (c *conn) scope(ctx context.Context) error {
+	id := tenant.FromContext(ctx)
+	if c.known && c.tenant == id {
+		return nil
+	}
+	if _, err := c.pgConn.ExecContext(ctx, `SELECT set_config('`+TenantSetting+`', $1, false)`, []driver.NamedValue{{Ordinal: 1, Value: id}}); err != nil {
+		return err
+	}
+	c.tenant, c.known = id, true
+	return nil
+}
+
+// wrote records a committed write in the session of ctx. When the position
+// of the write can't be told, the session reads from the primary instead.
+func (c *conn) wrote(ctx context.Context) {
+	s := sessionFrom(ctx)
+	if s == nil {
+		return
+	}
+	lsn, err := c.position(ctx)
+	if err != nil {
+		slog.Warn("postgres.lsn.failed", slog.String("db", c.db), slog.String("error", err.Error()))
+		lsn = math.MaxUint64
+	}
+	s.wrote(c.db, lsn)
+}
+
+func (c *conn) position(ctx context.Context) (uint64, error) {
+	rows, err := c.pgConn.QueryContext(ctx, lsnQuery, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		return 0, err
+	}
+	switch v := dest[0].(type) {
+	case []byte:
+		return parseLSN(string(v))
+	case string:
+		return parseLSN(v)
+	default:
+		return 0, fmt.Errorf("postgres: unexpected LSN %v", v)
+	}
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pgConn.QueryContext(ctx, query, args)
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	res, err := c.pgConn.ExecContext(ctx, query, args)
+	if err == nil && !c.inTx {
+		c.wrote(ctx)
+	}
+	return res, err
+}
+
+// PrepareContext scopes the session once, when preparing: executing a
+// statement such as COPY FROM STDIN allows nothing else on the connection
+// in between.
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pgConn.PrepareContext(ctx, query)
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	dt, err := c.pgConn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.inTx = true
+	return &tx{Tx: dt, conn: c, ctx: ctx, readOnly: opts.ReadOnly}, nil
+}
+
+type tx struct {
+	driver.Tx
+	conn *conn
+	// ctx is the context the transaction began with, whose session its
+	// writes are recorded in.
+	ctx      context.Context
+	readOnly bool
+}
+
+func (t *tx) Commit() error {
+	t.conn.inTx = false
+	if err := t.Tx.Commit(); err != nil {
+		t.conn.known = false
+		return err
+	}
+	if !t.readOnly {
+		t.conn.wrote(t.ctx)
+	}
+	return nil
+}
+
+func (t *tx) Rollback() error {
+	t.conn.inTx = false
+	t.conn.known = false
+	return t.Tx.Rollback()
+}
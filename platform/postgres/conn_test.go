@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/tenant"
+)
+
+// fakeConn records the statements sent to it, and the tenant each set. Every
+// statement other than a query of the WAL position advances the position.
+type fakeConn struct {
+	pgConn
+	statements []string
+	lsn        uint64
+	// bypass is whether the role of the session bypasses row-level security.
+	bypass bool
+	closed bool
+}
+
+func (c *fakeConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeConn) record(query string, args []driver.NamedValue) {
+	if len(args) == 1 && query == `SELECT set_config('`+TenantSetting+`', $1, false)` {
+		query = "set " + args[0].Value.(string)
+	}
+	c.statements = append(c.statements, query)
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.record(query, args)
+	c.lsn += 0x10
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	switch query {
+	case lsnQuery:
+		return &fakeRows{value: []byte(formatLSN(c.lsn))}, nil
+	case bypassQuery:
+		return &fakeRows{value: c.bypass}, nil
+	}
+	c.record(query, args)
+	c.lsn += 0x10
+	return nil, nil
+}
+
+func (c *fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+// fakeRows holds a single row of a single column.
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"lsn"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+// fakeConnector hands out conn.
+type fakeConnector struct {
+	driver.Connector
+	conn *fakeConn
+}
+
+func (c fakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func TestConnectorRefusesRolesBypassingRLS(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		bypass  bool
+		owner   bool
+		wantErr error
+	}{
+		{name: "held to policies", bypass: false},
+		{name: "bypassing policies", bypass: true, wantErr: ErrBypassesRLS},
+		{name: "owner bypassing policies", bypass: true, owner: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeConn{bypass: tt.bypass}
+			_, err := connector{Connector: fakeConnector{conn: fake}, db: "order", owner: tt.owner}.Connect(t.Context())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Connect error = %v, want %v", err, tt.wantErr)
+			}
+			if fake.closed != (tt.wantErr != nil) {
+				t.Errorf("connection closed = %v, want %v", fake.closed, tt.wantErr != nil)
+			}
+		})
+	}
+}
+
+func TestConnScopesStatements(t *testing.T) {
+	fake := &fakeConn{}
+	c := &conn{pgConn: fake, known: true}
+	acme := tenant.NewContext(t.Context(), "acme")
+	globex := tenant.NewContext(t.Context(), "globex")
+
+	c.QueryContext(t.Context(), "untenanted", nil)
+	c.QueryContext(acme, "acme 1", nil)
+	c.ExecContext(acme, "acme 2", nil)
+	c.QueryContext(globex, "globex", nil)
+	c.QueryContext(t.Context(), "untenanted", nil)
+
+	tx, _ := c.BeginTx(acme, driver.TxOptions{})
+	c.ExecContext(acme, "acme in tx", nil)
+	tx.Rollback()
+	// The rollback may have undone the setting, so it is set again.
+	c.QueryContext(acme, "acme after rollback", nil)
+
+	want := []string{
+		"untenanted",
+		"set acme", "acme 1", "acme 2",
+		"set globex", "globex",
+		"set ", "untenanted",
+		"set acme", "acme in tx",
+		"set acme", "acme after rollback",
+	}
+	if !slices.Equal(fake.statements, want) {
+		t.Errorf("statements = %q, want %q", fake.statements, want)
+	}
+}
+
+func TestConnRecordsCommittedWrites(t *testing.T) {
+	fake := &fakeConn{}
+	c := &conn{pgConn: fake, db: "order", known: true}
+	ctx := WithSession(t.Context())
+	s := sessionFrom(ctx)
+
+	c.ExecContext(ctx, "insert", nil)
+	if got := s.lastWrite("order"); got != fake.lsn {
+		t.Errorf("after an insert the session is at %s, want %s", formatLSN(got), formatLSN(fake.lsn))
+	}
+
+	before := s.lastWrite("order")
+	tx, _ := c.BeginTx(ctx, driver.TxOptions{})
+	c.ExecContext(ctx, "insert in tx", nil)
+	if got := s.lastWrite("order"); got != before {
+		t.Errorf("before the commit the session is at %s, want %s", formatLSN(got), formatLSN(before))
+	}
+	tx.Commit()
+	if got := s.lastWrite("order"); got != fake.lsn {
+		t.Errorf("after the commit the session is at %s, want %s", formatLSN(got), formatLSN(fake.lsn))
+	}
+
+	before = s.lastWrite("order")
+	tx, _ = c.BeginTx(ctx, driver.TxOptions{})
+	c.ExecContext(ctx, "insert in rolled back tx", nil)
+	tx.Rollback()
+	tx, _ = c.BeginTx(ctx, driver.TxOptions{ReadOnly: true})
+	c.QueryContext(ctx, "select", nil)
+	tx.Commit()
+	if got := s.lastWrite("order"); got != before {
+		t.Errorf("after a rollback and a read-only commit the session is at %s, want %s", formatLSN(got), formatLSN(before))
+	}
+	if got := s.lastWrite("account"); got != 0 {
+		t.Errorf("the session has written to account at %s", formatLSN(got))
+	}
+}
@@ -0,0 +1,23 @@
+#!/bin/sh
+# Creates the role the services connect as, APP_USER, when the postgres
+# image initializes a database. The image's POSTGRES_USER owns the tables
+# and runs migrations; as a superuser it would skip the tenant policies, so
+# services connect as APP_USER, which can't. Tables the owner creates later
+# are granted to APP_USER by default.
+#
+# Databases created before this script existed don't run it; run it once by
+# hand in their container instead:
+#
+#	docker compose exec -e APP_USER=... -e APP_PASSWORD=... order-db \
+#		sh /docker-entrypoint-initdb.d/app-role.sh
+set -eu
+
+psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" \
+	-v app_user="$APP_USER" -v app_password="$APP_PASSWORD" <<'SQL'
+CREATE ROLE :"app_user" LOGIN PASSWORD :'app_password' NOSUPERUSER NOCREATEDB NOCREATEROLE NOBYPASSRLS;
+GRANT USAGE ON SCHEMA public TO :"app_user";
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO :"app_user";
+GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO :"app_user";
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO :"app_user";
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT USAGE, SELECT ON SEQUENCES TO :"app_user";
+SQL
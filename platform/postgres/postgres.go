@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/XSAM/otelsql"
+	"github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// Config is the connection pool of one database. Services embed it with
+// their own prefix, e.g. `envPrefix:"ACCOUNT_POSTGRES_"`.
+type Config struct {
+	Host string `env:"HOST" envDefault:"localhost"`
+	Port string `env:"PORT" envDefault:"5432"`
+	// User is the role statements run as. Tenants are kept apart by
+	// row-level security, which superusers and roles with BYPASSRLS skip,
+	// so Connect refuses such roles.
+	User     string `env:"USER"`
+	Password string `env:"PASSWORD"`
+	// MigrationUser owns the tables and runs the migrations; see
+	// ConnectOwner. It defaults to User.
+	MigrationUser     string        `env:"MIGRATION_USER"`
+	MigrationPassword string        `env:"MIGRATION_PASSWORD"`
+	Name              string        `env:"NAME"`
+	SSLMode           string        `env:"SSL_MODE" envDefault:"disable"`
+	MaxOpenConns      int           `env:"MAX_OPEN_CONNS" envDefault:"25"`
+	MaxIdleConns      int           `env:"MAX_IDLE_CONNS" envDefault:"25"`
+	MaxIdleTime       time.Duration `env:"MAX_IDLE_TIME" envDefault:"15m"`
+	MaxLifetime       time.Duration `env:"MAX_LIFETIME"`
+	Timeout           time.Duration `env:"TIMEOUT" envDefault:"5s"`
+	// ReplicaDSNs are connection strings of read replicas, in URL form. They
+	// are held to the same role restrictions as User.
+	ReplicaDSNs   []string      `env:"REPLICA_DSNS" envSeparator:","`
+	MaxReplicaLag time.Duration `env:"MAX_REPLICA_LAG" envDefault:"5s"`
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Name == "" {
+		errs = append(errs, errors.New("postgres: database name is required"))
+	}
+	if c.User == "" {
+		errs = append(errs, errors.New("postgres: user is required"))
+	}
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("postgres: connection limits can't be negative"))
+	}
+	if c.Timeout <= 0 {
+		errs = append(errs, errors.New("postgres: timeout must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) dsn(user, password string) string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", c.Host, c.Port, user, password, c.Name, c.SSLMode)
+}
+
+// Connect opens the pool described by config and pings the database, giving
+// up after config.Timeout. It fails with ErrBypassesRLS when config.User
+// isn't held to row-level security.
+func Connect(ctx context.Context, config Config) (*sql.DB, error) {
+	return connect(ctx, config.dsn(config.User, config.Password), config, false)
+}
+
+// ConnectOwner is Connect as config.MigrationUser, for migrations, which
+// create the tables and policies the other pools are held to.
+func ConnectOwner(ctx context.Context, config Config) (*sql.DB, error) {
+	if config.MigrationUser == "" {
+		return Connect(ctx, config)
+	}
+	return connect(ctx, config.dsn(config.MigrationUser, config.MigrationPassword), config, true)
+}
+
+func connect(ctx context.Context, dsn string, config Config, owner bool) (*sql.DB, error) {
+	db, err := open(dsn, config, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// open creates a pool with the limits of config whose statements are scoped
+// to their tenant and whose writes are recorded in their session; it doesn't
+// connect yet. Unless the pool is the owner's, its connections check that
+// their role is held to row-level security.
+func open(dsn string, config Config, owner bool) (*sql.DB, error) {
+	pqConnector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	// Statements, not their arguments, are recorded on the spans.
+	db := otelsql.OpenDB(connector{Connector: pqConnector, db: config.Name, owner: owner},
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true, OmitRows: true}),
+	)
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetConnMaxIdleTime(config.MaxIdleTime)
+	db.SetConnMaxLifetime(config.MaxLifetime)
+	return db, nil
+}
@@ -0,0 +1,276 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/saleh-ghazimoradi/MircoEcoMarket/platform/metrics"
+)
+
+const (
+	poolPrimary = "primary"
+	// replicaCheckInterval is how often replicas are pinged and their lag
+	// measured.
+	replicaCheckInterval = 5 * time.Second
+	replicaCheckTimeout  = 2 * time.Second
+)
+
+// replayQuery returns the position up to which a replica has replayed the
+// primary's WAL. It is NULL on servers that aren't replicas.
+const replayQuery = `SELECT pg_last_wal_replay_lsn()::text`
+
+var errNotReplica = errors.New("postgres: server is not a replica")
+
+var (
+	routedQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_routed_queries_total",
+		Help: "Queries sent through a read/write router, by database and the pool that served them.",
+	}, []string{"db", "pool"})
+	replicaLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_replica_lag_seconds",
+		Help: "Replication lag of each replica as of its last check.",
+	}, []string{"db", "pool"})
+	replicaUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_replica_up",
+		Help: "Whether a replica passed its last check and is within the allowed lag.",
+	}, []string{"db", "pool"})
+)
+
+// Reader is what repositories read through: a *sql.DB or a Router.
+type Reader interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Writer is what repositories write through: a *sql.DB or a Router.
+type Writer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+type replica struct {
+	name string
+	db   *sql.DB
+	// replayed is the WAL position up to which the replica had replayed the
+	// primary's writes when last checked. usable is false while the replica
+	// fails its checks or lags more than allowed.
+	replayed atomic.Uint64
+	usable   atomic.Bool
+}
+
+// position is where the primary's WAL was when the router looked.
+type position struct {
+	at  time.Time
+	lsn uint64
+}
+
+// Router sends writes to the primary and spreads reads over the replicas
+// that are up and close enough to it. A session that wrote reads from the
+// primary until a check finds a replica that has replayed its write; see
+// WithSession. Without usable replicas, reads fall back to the primary.
+//
+// Lag is measured against the router's own record of the primary's WAL
+// position over time, so no clocks of different hosts are compared: a
+// replica that has replayed what the primary had written at some point lags
+// by the time the primary has written more since.
+type Router struct {
+	name     string
+	primary  *sql.DB
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+	// positions are the primary's, oldest first, going back a little more
+	// than maxLag. Only Run touches them.
+	positions []position
+}
+
+func (r *Router) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return r.reader(ctx).QueryContext(ctx, query, args...)
+}
+
+func (r *Router) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return r.reader(ctx).QueryRowContext(ctx, query, args...)
+}
+
+func (r *Router) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	routedQueries.WithLabelValues(r.name, poolPrimary).Inc()
+	return r.primary.ExecContext(ctx, query, args...)
+}
+
+func (r *Router) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	routedQueries.WithLabelValues(r.name, poolPrimary).Inc()
+	return r.primary.BeginTx(ctx, opts)
+}
+
+// reader picks the pool for a read: the next usable replica that has
+// replayed the last write of the session, else the primary.
+func (r *Router) reader(ctx context.Context) *sql.DB {
+	var lastWrite uint64
+	if s := sessionFrom(ctx); s != nil {
+		lastWrite = s.lastWrite(r.name)
+	}
+
+	n := len(r.replicas)
+	start := r.next.Add(1)
+	for i := range n {
+		rep := r.replicas[(start+uint64(i))%uint64(n)]
+		if !rep.usable.Load() {
+			continue
+		}
+		if rep.replayed.Load() < lastWrite {
+			continue
+		}
+		routedQueries.WithLabelValues(r.name, rep.name).Inc()
+		return rep.db
+	}
+	routedQueries.WithLabelValues(r.name, poolPrimary).Inc()
+	return r.primary
+}
+
+// Run checks the replicas right away and then periodically until ctx is
+// done.
+func (r *Router) Run(ctx context.Context) error {
+	if len(r.replicas) == 0 {
+		return nil
+	}
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		r.sample(ctx)
+		for _, rep := range r.replicas {
+			r.check(ctx, rep)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sample records where the primary's WAL is now. While the primary can't be
+// reached, the positions stay as they were and replicas are measured against
+// the last one known.
+func (r *Router) sample(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+	defer cancel()
+
+	var current string
+	if err := r.primary.QueryRowContext(ctx, lsnQuery).Scan(&current); err != nil {
+		slog.Warn("postgres.primary.lsn.failed", slog.String("db", r.name), slog.String("error", err.Error()))
+		return
+	}
+	lsn, err := parseLSN(current)
+	if err != nil {
+		slog.Warn("postgres.primary.lsn.failed", slog.String("db", r.name), slog.String("error", err.Error()))
+		return
+	}
+
+	now := time.Now()
+	r.positions = append(r.positions, position{at: now, lsn: lsn})
+	// Keep one position older than maxLag, to tell replicas within it.
+	keep := 0
+	for keep < len(r.positions)-1 && now.Sub(r.positions[keep+1].at) > r.maxLag {
+		keep++
+	}
+	r.positions = r.positions[keep:]
+}
+
+// lag returns how long ago, by the primary's last recorded position, the
+// primary was where a replica that replayed up to lsn is. Between two
+// positions the primary is taken to have written at an even pace. ok is
+// false when the replica is behind every recorded position, or none was
+// recorded.
+func (r *Router) lag(lsn uint64) (lag time.Duration, ok bool) {
+	if len(r.positions) == 0 {
+		return 0, false
+	}
+	last := r.positions[len(r.positions)-1]
+	if lsn >= last.lsn {
+		return 0, true
+	}
+	for i := len(r.positions) - 2; i >= 0; i-- {
+		p, next := r.positions[i], r.positions[i+1]
+		if p.lsn <= lsn {
+			share := float64(lsn-p.lsn) / float64(next.lsn-p.lsn)
+			at := p.at.Add(time.Duration(share * float64(next.at.Sub(p.at))))
+			return last.at.Sub(at), true
+		}
+	}
+	return last.at.Sub(r.positions[0].at), false
+}
+
+func (r *Router) check(ctx context.Context, rep *replica) {
+	ctx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+	defer cancel()
+
+	var replayed sql.NullString
+	err := rep.db.QueryRowContext(ctx, replayQuery).Scan(&replayed)
+	if err == nil && !replayed.Valid {
+		err = errNotReplica
+	}
+	var lsn uint64
+	if err == nil {
+		lsn, err = parseLSN(replayed.String)
+	}
+	if err != nil {
+		if rep.usable.Swap(false) && ctx.Err() == nil {
+			slog.Warn("postgres.replica.down", slog.String("db", r.name), slog.String("pool", rep.name), slog.String("error", err.Error()))
+		}
+		replicaUp.WithLabelValues(r.name, rep.name).Set(0)
+		return
+	}
+
+	rep.replayed.Store(lsn)
+	lag, ok := r.lag(lsn)
+	replicaLag.WithLabelValues(r.name, rep.name).Set(lag.Seconds())
+	usable := ok && lag <= r.maxLag
+	if rep.usable.Swap(usable) != usable {
+		slog.Info("postgres.replica.changed", slog.String("db", r.name), slog.String("pool", rep.name), slog.Bool("usable", usable), slog.Duration("lag", lag))
+	}
+	if usable {
+		replicaUp.WithLabelValues(r.name, rep.name).Set(1)
+	} else {
+		replicaUp.WithLabelValues(r.name, rep.name).Set(0)
+	}
+}
+
+// Close closes the replica pools. The primary belongs to the caller.
+func (r *Router) Close() error {
+	var errs []error
+	for _, rep := range r.replicas {
+		errs = append(errs, rep.db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// NewRouter routes between primary and the replicas listed in config. Replicas
+// are opened without waiting for them and serve reads once Run found them
+// healthy.
+func NewRouter(primary *sql.DB, config Config) (*Router, error) {
+	r := &Router{
+		name:    config.Name,
+		primary: primary,
+		maxLag:  config.MaxReplicaLag,
+	}
+	for i, dsn := range config.ReplicaDSNs {
+		db, err := open(dsn, config, false)
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("replica %d: %w", i, err)
+		}
+
+		name := fmt.Sprintf("replica-%d", i)
+		metrics.RegisterDB(db, config.Name+"-"+name)
+		replicaUp.WithLabelValues(config.Name, name).Set(0)
+		r.replicas = append(r.replicas, &replica{name: name, db: db})
+	}
+	return r, nil
+}
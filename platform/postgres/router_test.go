@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRouterLag(t *testing.T) {
+	start := time.Now()
+	r := &Router{maxLag: 5 * time.Second, positions: []position{
+		{at: start, lsn: 0x100},
+		{at: start.Add(5 * time.Second), lsn: 0x200},
+		{at: start.Add(10 * time.Second), lsn: 0x200},
+	}}
+	tests := []struct {
+		lsn    uint64
+		want   time.Duration
+		wantOK bool
+	}{
+		// The primary has written nothing since 0x200: an idle primary
+		// doesn't make replicas lag.
+		{lsn: 0x200, want: 0, wantOK: true},
+		{lsn: 0x300, want: 0, wantOK: true},
+		{lsn: 0x180, want: 7500 * time.Millisecond, wantOK: true},
+		{lsn: 0x100, want: 10 * time.Second, wantOK: true},
+		// Behind everything recorded, e.g. disconnected for long.
+		{lsn: 0x80, want: 10 * time.Second, wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := r.lag(tt.lsn)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("lag(%s) = %v, %v, want %v, %v", formatLSN(tt.lsn), got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if _, ok := (&Router{}).lag(0x100); ok {
+		t.Error("lag without recorded positions is ok, want unknown")
+	}
+}
+
+func TestRouterReadsSessionWritesFromCaughtUpReplicas(t *testing.T) {
+	// The pools are never used, only told apart.
+	primary := new(sql.DB)
+	behind := &replica{name: "replica-0", db: new(sql.DB)}
+	caughtUp := &replica{name: "replica-1", db: new(sql.DB)}
+	for _, rep := range []*replica{behind, caughtUp} {
+		rep.usable.Store(true)
+	}
+	behind.replayed.Store(0x100)
+	caughtUp.replayed.Store(0x200)
+	r := &Router{name: "order", primary: primary, replicas: []*replica{behind, caughtUp}}
+
+	ctx := WithSession(t.Context())
+	sessionFrom(ctx).wrote("order", 0x200)
+	for range 4 {
+		if r.reader(ctx) != caughtUp.db {
+			t.Fatal("a read after a write went to another pool than the caught up replica")
+		}
+	}
+
+	sessionFrom(ctx).wrote("order", 0x300)
+	if r.reader(ctx) != primary {
+		t.Error("a read after a write no replica has replayed didn't go to the primary")
+	}
+
+	// A write to another database says nothing about this one.
+	ctx = WithSession(t.Context())
+	sessionFrom(ctx).wrote("account", 0x300)
+	read := map[*sql.DB]bool{}
+	for range 4 {
+		read[r.reader(ctx)] = true
+	}
+	if !read[behind.db] || !read[caughtUp.db] || read[primary] {
+		t.Error("reads after a write to another database weren't spread over the replicas")
+	}
+}
+
+func TestSessionMetadata(t *testing.T) {
+	s := &session{}
+	s.resume([]string{"order=16/B374D848", "account=0/10", "broken", "cart=nope"})
+	if got := s.lastWrite("order"); formatLSN(got) != "16/B374D848" {
+		t.Errorf("order = %s, want 16/B374D848", formatLSN(got))
+	}
+
+	start := s.positions()
+	s.wrote("account", 0x5)
+	s.wrote("cart", 0x20)
+	if got, want := s.metadata(start), []string{"cart=0/20"}; !slices.Equal(got, want) {
+		t.Errorf("metadata since start = %q, want %q", got, want)
+	}
+	if got, want := s.metadata(nil), []string{"account=0/10", "cart=0/20", "order=16/B374D848"}; !slices.Equal(got, want) {
+		t.Errorf("metadata = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// LastWriteKey carries the WAL positions (LSNs) of a caller's last writes in
+// gRPC metadata, one "<database>=<LSN>" value per database written to, e.g.
+// "order=16/B374D848". Servers send it in the trailer of calls that wrote; a
+// caller that sends it back on a later call reads its own writes there too.
+const LastWriteKey = "x-last-write"
+
+type sessionKey struct{}
+
+// session remembers, by database name, the WAL position of the last write of
+// the request it belongs to. Positions of different databases can't be
+// compared, so each is kept apart.
+type session struct {
+	mu   sync.Mutex
+	lsns map[string]uint64
+}
+
+// wrote raises the position of db to lsn, and reports whether it rose.
+func (s *session) wrote(db string, lsn uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lsn <= s.lsns[db] {
+		return false
+	}
+	if s.lsns == nil {
+		s.lsns = make(map[string]uint64)
+	}
+	s.lsns[db] = lsn
+	return true
+}
+
+// lastWrite returns the position of the last write to db, or 0 if there was
+// none.
+func (s *session) lastWrite(db string) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lsns[db]
+}
+
+func (s *session) positions() map[string]uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Clone(s.lsns)
+}
+
+// metadata returns the positions that are past those in since as values of
+// LastWriteKey.
+func (s *session) metadata(since map[string]uint64) []string {
+	var values []string
+	for db, lsn := range s.positions() {
+		if lsn > since[db] {
+			values = append(values, db+"="+formatLSN(lsn))
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// resume records the positions in values of LastWriteKey, skipping those it
+// can't parse.
+func (s *session) resume(values []string) {
+	for _, v := range values {
+		db, position, ok := strings.Cut(v, "=")
+		if !ok {
+			continue
+		}
+		if lsn, err := parseLSN(position); err == nil {
+			s.wrote(db, lsn)
+		}
+	}
+}
+
+func sessionFrom(ctx context.Context) *session {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	return s
+}
+
+// WithSession returns a context whose reads through a Router see the writes
+// made with it, or with a context derived from it.
+func WithSession(ctx context.Context) context.Context {
+	if sessionFrom(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, sessionKey{}, &session{})
+}
+
+// Middleware gives every HTTP request a session, so that the gRPC calls made
+// while serving it read the writes of the calls before them.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(WithSession(r.Context())))
+	})
+}
+
+// serverSession starts the session of a call, resuming the last writes the
+// caller reported.
+func serverSession(ctx context.Context) (context.Context, *session) {
+	ctx = WithSession(ctx)
+	s := sessionFrom(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		s.resume(md.Get(LastWriteKey))
+	}
+	return ctx, s
+}
+
+// writeTrailer returns the trailer that reports the writes the call made
+// after the positions in start, if any. A trailer rather than a header keeps
+// failed calls retryable.
+func writeTrailer(s *session, start map[string]uint64) metadata.MD {
+	values := s.metadata(start)
+	if len(values) == 0 {
+		return nil
+	}
+	md := metadata.MD{}
+	md.Append(LastWriteKey, values...)
+	return md
+}
+
+// UnaryServerInterceptor gives every call a session.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, s := serverSession(ctx)
+		start := s.positions()
+		resp, err := handler(ctx, req)
+		if md := writeTrailer(s, start); md != nil {
+			_ = grpc.SetTrailer(ctx, md)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor gives every stream a session.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, s := serverSession(ss.Context())
+		start := s.positions()
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		if md := writeTrailer(s, start); md != nil {
+			ss.SetTrailer(md)
+		}
+		return err
+	}
+}
+
+// UnaryClientInterceptor carries the session of ctx, if any, across calls:
+// it reports the last writes to the server and records the writes the server
+// reports back, so that the caller's later calls read them.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		s := sessionFrom(ctx)
+		if s == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		for _, v := range s.metadata(nil) {
+			ctx = metadata.AppendToOutgoingContext(ctx, LastWriteKey, v)
+		}
+		var trailer metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Trailer(&trailer))...)
+		s.resume(trailer.Get(LastWriteKey))
+		return err
+	}
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// parseLSN parses a WAL position as Postgres prints it, e.g. "16/B374D848".
+func parseLSN(s string) (uint64, error) {
+	hi, lo, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, fmt.Errorf("postgres: invalid LSN %q", s)
+	}
+	h, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: invalid LSN %q", s)
+	}
+	l, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: invalid LSN %q", s)
+	}
+	return h<<32 | l, nil
+}
+
+func formatLSN(lsn uint64) string {
+	return fmt.Sprintf("%X/%X", lsn>>32, uint32(lsn))
+}
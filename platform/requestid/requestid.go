@@ -0,0 +1,44 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/segmentio/ksuid"
+)
+
+const (
+	// Header carries the request id between clients, the gateway and services.
+	Header = "X-Request-Id"
+	// MaxLength bounds the ids accepted from callers; longer ones are replaced.
+	MaxLength = 128
+)
+
+type requestIdKey struct{}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, id)
+}
+
+// FromContext returns the request id stored in ctx, or "" when there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
+
+func New() string {
+	return ksuid.New().String()
+}
+
+// Middleware keeps the caller's X-Request-Id, or assigns a new one, stores it
+// in the request context and echoes it on the response.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if id == "" || len(id) > MaxLength {
+			id = New()
+		}
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+	})
+}
@@ -0,0 +1,121 @@
+// Package tenant identifies the storefront a request belongs to. The gateway
+// resolves the tenant from the Host header, gRPC carries it between services
+// in metadata (see grpcx), and the repositories scope every read and write
+// to the tenant of their context.
+//
+// No user tokens pass through the gateway, so there is no token claim to
+// take the tenant from: the host is the only source, and callers can't pick
+// another tenant than the one their host maps to.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	// MaxLength bounds tenant ids.
+	MaxLength = 64
+	// Default is the tenant of data written before tenants existed, and of
+	// hosts that aren't listed when no other default is configured.
+	Default = "default"
+)
+
+// ErrMissing is returned for work that needs a tenant when its context has
+// none.
+var ErrMissing = errors.New("tenant: no tenant in context")
+
+type tenantKey struct{}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// FromContext returns the tenant stored in ctx, or "" when there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(tenantKey{}).(string)
+	return id
+}
+
+// Valid reports whether id is a well-formed tenant id: lower case letters,
+// digits and dashes, starting with a letter or digit.
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength || id[0] == '-' {
+		return false
+	}
+	for _, r := range id {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// Config maps the hosts the gateway serves to their tenants.
+type Config struct {
+	// Hosts lists host=tenant pairs, e.g. "shop.example.com=shop".
+	Hosts map[string]string `env:"TENANT_HOSTS" envKeyValSeparator:"="`
+	// Fallback serves hosts missing from Hosts; empty rejects them.
+	Fallback string `env:"TENANT_FALLBACK" envDefault:"default"`
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	for host, id := range c.Hosts {
+		if !Valid(id) {
+			errs = append(errs, fmt.Errorf("tenant: invalid tenant %q for host %q", id, host))
+		}
+	}
+	if c.Fallback != "" && !Valid(c.Fallback) {
+		errs = append(errs, fmt.Errorf("tenant: invalid fallback tenant %q", c.Fallback))
+	}
+	return errors.Join(errs...)
+}
+
+// Tenants returns every tenant the hosts and the fallback map to, sorted,
+// for work done on behalf of each tenant rather than of a request.
+func (c *Config) Tenants() []string {
+	ids := make([]string, 0, len(c.Hosts)+1)
+	for _, id := range c.Hosts {
+		ids = append(ids, id)
+	}
+	if c.Fallback != "" {
+		ids = append(ids, c.Fallback)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// Resolve returns the tenant of host, which may carry a port.
+func (c *Config) Resolve(host string) (string, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for h, id := range c.Hosts {
+		if strings.EqualFold(h, host) {
+			return id, true
+		}
+	}
+	return c.Fallback, c.Fallback != ""
+}
+
+// Middleware stores the tenant of the request's host in its context and
+// answers 404 for hosts that belong to no tenant.
+func Middleware(config Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id, ok := config.Resolve(r.Host)
+			if !ok {
+				http.Error(w, "unknown host", http.StatusNotFound)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package tenant
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	config := Config{Hosts: map[string]string{"shop.example.com": "shop", "Outlet.example.com": "outlet"}}
+	tests := []struct {
+		host     string
+		fallback string
+		want     string
+		wantOK   bool
+	}{
+		{host: "shop.example.com", want: "shop", wantOK: true},
+		{host: "shop.example.com:8080", want: "shop", wantOK: true},
+		{host: "SHOP.example.com.", want: "shop", wantOK: true},
+		{host: "outlet.example.com", want: "outlet", wantOK: true},
+		{host: "other.example.com"},
+		{host: "other.example.com", fallback: Default, want: Default, wantOK: true},
+	}
+	for _, tt := range tests {
+		config.Fallback = tt.fallback
+		got, ok := config.Resolve(tt.host)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Resolve(%q) with fallback %q = %q, %v, want %q, %v", tt.host, tt.fallback, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestTenants(t *testing.T) {
+	config := Config{
+		Hosts:    map[string]string{"shop.example.com": "shop", "www.shop.example.com": "shop", "outlet.example.com": "outlet"},
+		Fallback: Default,
+	}
+	if got, want := config.Tenants(), []string{Default, "outlet", "shop"}; !slices.Equal(got, want) {
+		t.Errorf("Tenants() = %q, want %q", got, want)
+	}
+	config.Fallback = ""
+	if got, want := config.Tenants(), []string{"outlet", "shop"}; !slices.Equal(got, want) {
+		t.Errorf("Tenants() without a fallback = %q, want %q", got, want)
+	}
+}
+
+func TestValid(t *testing.T) {
+	for id, want := range map[string]bool{
+		"shop":    true,
+		"shop-eu": true,
+		"42":      true,
+		"":        false,
+		"-shop":   false,
+		"Shop":    false,
+		"shop_eu": false,
+		"shop eu": false,
+	} {
+		if got := Valid(id); got != want {
+			t.Errorf("Valid(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var got string
+	h := Middleware(Config{Hosts: map[string]string{"shop.example.com": "shop"}})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "http://shop.example.com/query", nil)
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got != "shop" {
+		t.Errorf("tenant = %q, want shop", got)
+	}
+
+	got = ""
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://other.example.com/query", nil))
+	if rec.Code != http.StatusNotFound || got != "" {
+		t.Errorf("unknown host: status %d, tenant %q, want 404 and no call", rec.Code, got)
+	}
+}